@@ -0,0 +1,152 @@
+package safecast
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// AlphabetBase36 is the alphabet of the base 36, as used by [WithBase] with 36.
+	AlphabetBase36 = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// AlphabetCrockford is the alphabet of the [Crockford's Base32], used by identifiers such as ULIDs.
+	//
+	// The letters I, L, O, and U are excluded to avoid confusion.
+	// Only the canonical digits are accepted by [WithAlphabet]: unlike what the specification allows when decoding,
+	// O is not read as 0, I and L are not read as 1, and the hyphens are not ignored, so "O1" and "3NQ-K8N" are rejected.
+	//
+	// [Crockford's Base32]: https://www.crockford.com/base32.html
+	AlphabetCrockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// AlphabetBase58 is the alphabet of the Base58 encoding used by Bitcoin and IPFS.
+	//
+	// The characters 0, O, I, and l are excluded to avoid confusion.
+	AlphabetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// WithAlphabet sets the digits of the number base when used with [Parse], the base being the number of digits.
+//
+// The first character is the digit with the value 0, the second one with the value 1, and so on.
+// See [AlphabetCrockford] and [AlphabetBase58] for the common alphabets.
+//
+// When the letters of the alphabet have all the same case, the letters are case-insensitive,
+// so "01ARZ3NDEKTSV4RRFFQ69G5FAV" and "01arz3ndektsv4rrffq69g5fav" are the same with [AlphabetCrockford].
+// A leading "-" is accepted for the negative numbers, unless "-" is one of the digits.
+//
+// It takes precedence over the other options setting the number base, such as [WithBase].
+// [Parse] fails with [ErrStringConversion] when the alphabet has less than two digits or has duplicated digits.
+//
+// Example:
+//
+//	value, err := Parse[uint64]("2NEpo7TZRRrLZSi2U", WithAlphabet(AlphabetBase58))
+func WithAlphabet(alphabet string) ParseOption {
+	return func(pc *parseConfig) {
+		pc.alphabet = alphabet
+	}
+}
+
+// parseAlphabet parses s with the digits of the alphabet of the options.
+//
+// orig is the string reported in the errors.
+func parseAlphabet[NumOut Number](orig, s string, options *parseConfig) (NumOut, error) {
+	alphabet := []rune(options.alphabet)
+
+	syntaxError := func(reason string) (NumOut, error) {
+		e := newConversionError[NumOut](orig, ErrStringConversion)
+		e.Base = len(alphabet)
+		e.Reason = reason
+		return 0, e
+	}
+
+	digits, ok := newDigitTable(alphabet)
+	if !ok {
+		return syntaxError("invalid alphabet")
+	}
+
+	var isNegative bool
+	if _, isDigit := digits['-']; !isDigit {
+		s, isNegative = strings.CutPrefix(s, "-")
+	}
+	if s == "" {
+		return syntaxError("")
+	}
+
+	base := big.NewInt(int64(len(alphabet)))
+	value := new(big.Int)
+	overflow := false
+	for _, r := range s {
+		digit, ok := digits[r]
+		if !ok {
+			return syntaxError("")
+		}
+
+		// the remaining digits are still validated, but the value is no longer needed
+		// once it is out of the range of all the types
+		if !overflow {
+			value.Mul(value, base)
+			value.Add(value, big.NewInt(int64(digit)))
+			overflow = value.BitLen() > 64
+		}
+	}
+
+	if options.strict {
+		first, _ := utf8.DecodeRuneInString(s)
+		switch {
+		case strings.IndexFunc(s, func(r rune) bool { return !strings.ContainsRune(options.alphabet, r) }) >= 0:
+			// the letters are only accepted in the case of the alphabet
+			return syntaxError("letter case")
+		case digits[first] == 0 && len(s) > utf8.RuneLen(first):
+			return syntaxError("leading zero")
+		case isNegative && value.Sign() == 0:
+			return syntaxError("negative zero")
+		}
+	}
+
+	if isNegative {
+		value.Neg(value)
+	}
+
+	if overflow {
+		return 0, getBigRangeError[NumOut](orig, value.Sign())
+	}
+
+	converted, err := ConvertFromBigInt[NumOut](value, options.convertOptions...)
+	if err != nil {
+		return converted, withValue(orig, err)
+	}
+	return converted, nil
+}
+
+// newDigitTable returns the value of each digit of the alphabet.
+//
+// The letters are case-insensitive when they have all the same case in the alphabet.
+func newDigitTable(alphabet []rune) (map[rune]int, bool) {
+	if len(alphabet) < 2 {
+		return nil, false
+	}
+
+	var hasUpper, hasLower bool
+	for _, r := range alphabet {
+		hasUpper = hasUpper || unicode.IsUpper(r)
+		hasLower = hasLower || unicode.IsLower(r)
+	}
+	caseInsensitive := hasUpper != hasLower
+
+	digits := make(map[rune]int, 2*len(alphabet))
+	for i, r := range alphabet {
+		if _, duplicated := digits[r]; duplicated {
+			return nil, false
+		}
+		digits[r] = i
+	}
+
+	if caseInsensitive {
+		for i, r := range alphabet {
+			digits[unicode.ToUpper(r)] = i
+			digits[unicode.ToLower(r)] = i
+		}
+	}
+	return digits, true
+}
@@ -0,0 +1,133 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParse_withBase(t *testing.T) {
+	base := func(n int) []safecast.ParseOption {
+		return []safecast.ParseOption{safecast.WithBase(n)}
+	}
+
+	for name, c := range map[string]TestRunner{
+		"base 2":                     MapTestParse[uint8]{Input: "101010", ParseOptions: base(2), ExpectedOutput: 42},
+		"base 3":                     MapTestParse[uint8]{Input: "1120", ParseOptions: base(3), ExpectedOutput: 42},
+		"base 10":                    MapTestParse[uint8]{Input: "42", ParseOptions: base(10), ExpectedOutput: 42},
+		"base 16":                    MapTestParse[uint8]{Input: "2a", ParseOptions: base(16), ExpectedOutput: 42},
+		"base 32":                    MapTestParse[uint8]{Input: "1a", ParseOptions: base(32), ExpectedOutput: 42},
+		"base 36":                    MapTestParse[int64]{Input: "zik0zj", ParseOptions: base(36), ExpectedOutput: math.MaxInt32},
+		"base 36 uppercase":          MapTestParse[int64]{Input: "ZIK0ZJ", ParseOptions: base(36), ExpectedOutput: math.MaxInt32},
+		"base 36 negative":           MapTestParse[int64]{Input: "-zik0zk", ParseOptions: base(36), ExpectedOutput: math.MinInt32},
+		"base 36 max uint64":         MapTestParse[uint64]{Input: "3w5e11264sgsf", ParseOptions: base(36), ExpectedOutput: math.MaxUint64},
+		"last option wins":           MapTestParse[uint8]{Input: "42", ParseOptions: []safecast.ParseOption{safecast.WithBase(16), safecast.WithBaseDecimal()}, ExpectedOutput: 42},
+		"with convert options":       MapTestParse[uint8]{Input: "zz", ParseOptions: []safecast.ParseOption{safecast.WithBase(36), safecast.WithConvertOptions(safecast.WithSaturation())}, ExpectedOutput: math.MaxUint8},
+		"base 36 overflows":          MapTestParse[uint64]{Input: "3w5e11264sgsg", ParseOptions: base(36), ExpectedError: safecast.ErrExceedMaximumValue},
+		"base 36 overflows uint8":    MapTestParse[uint8]{Input: "zz", ParseOptions: base(36), ExpectedError: safecast.ErrExceedMaximumValue},
+		"digit out of base":          MapTestParse[uint8]{Input: "12", ParseOptions: base(2), ExpectedError: safecast.ErrStringConversion, ErrorContains: "(base binary)"},
+		"digit out of other base":    MapTestParse[uint8]{Input: "z", ParseOptions: base(35), ExpectedError: safecast.ErrStringConversion, ErrorContains: "cannot convert from `z` to uint8 (base 35)"},
+		"base 0 is invalid":          MapTestParse[uint8]{Input: "42", ParseOptions: base(0), ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid base"},
+		"base 1 is invalid":          MapTestParse[uint8]{Input: "0", ParseOptions: base(1), ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid base"},
+		"base 37 is invalid":         MapTestParse[uint8]{Input: "42", ParseOptions: base(37), ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid base"},
+		"negative base is invalid":   MapTestParse[uint8]{Input: "42", ParseOptions: base(-16), ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid base"},
+		"exponent is a digit":        MapTestParse[uint16]{Input: "1e3", ParseOptions: base(20), ExpectedOutput: 20*20 + 14*20 + 3},
+		"fractional part in base 3":  MapTestParse[uint8]{Input: "1.2", ParseOptions: base(3), ExpectedError: safecast.ErrStringConversion},
+		"fractional part in base 36": MapTestParse[uint8]{Input: "1.2", ParseOptions: base(36), ExpectedError: safecast.ErrStringConversion},
+		"fractional part in base 16": MapTestParse[float64]{Input: "1.5", ParseOptions: base(16), ExpectedOutput: 1.5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func TestParse_withAlphabet(t *testing.T) {
+	crockford := []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetCrockford)}
+	base58 := []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetBase58)}
+	base36 := []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetBase36)}
+
+	for name, c := range map[string]TestRunner{
+		"crockford":                 MapTestParse[uint64]{Input: "3NQK8N", ParseOptions: crockford, ExpectedOutput: 123456789},
+		"crockford lowercase":       MapTestParse[uint64]{Input: "3nqk8n", ParseOptions: crockford, ExpectedOutput: 123456789},
+		"crockford max uint64":      MapTestParse[uint64]{Input: "FZZZZZZZZZZZZ", ParseOptions: crockford, ExpectedOutput: math.MaxUint64},
+		"crockford zero":            MapTestParse[uint64]{Input: "0", ParseOptions: crockford, ExpectedOutput: 0},
+		"base58":                    MapTestParse[uint64]{Input: "BukQL", ParseOptions: base58, ExpectedOutput: 123456789},
+		"base58 max uint64":         MapTestParse[uint64]{Input: "jpXCZedGfVQ", ParseOptions: base58, ExpectedOutput: math.MaxUint64},
+		"base58 leading zero digit": MapTestParse[uint64]{Input: "11BukQL", ParseOptions: base58, ExpectedOutput: 123456789},
+		"base36 alphabet":           MapTestParse[int64]{Input: "zik0zj", ParseOptions: base36, ExpectedOutput: math.MaxInt32},
+		"negative":                  MapTestParse[int]{Input: "-BukQL", ParseOptions: base58, ExpectedOutput: -123456789},
+		"binary alphabet":           MapTestParse[uint8]{Input: "xoxoxo", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("ox")}, ExpectedOutput: 42},
+		"dash is a digit":           MapTestParse[uint8]{Input: "-+", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("-+")}, ExpectedOutput: 1},
+		"unicode digits":            MapTestParse[uint8]{Input: "βα", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("αβγ")}, ExpectedOutput: 3},
+		"precedence over base":      MapTestParse[uint8]{Input: "11", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("01"), safecast.WithBaseHexadecimal()}, ExpectedOutput: 3},
+		"with lenient":              MapTestParse[uint64]{Input: " BukQL ", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetBase58), safecast.WithLenient()}, ExpectedOutput: 123456789},
+		"with convert options": MapTestParse[uint8]{
+			Input:          "BukQL",
+			ParseOptions:   []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetBase58), safecast.WithConvertOptions(safecast.WithSaturation())},
+			ExpectedOutput: math.MaxUint8,
+		},
+
+		"base58 is case-sensitive": MapTestParse[uint64]{Input: "bukql", ParseOptions: base58, ExpectedOutput: 0, ExpectedError: safecast.ErrStringConversion},
+		"crockford alias":          MapTestParse[uint64]{Input: "O1", ParseOptions: crockford, ExpectedError: safecast.ErrStringConversion},
+		"crockford alias of one":   MapTestParse[uint64]{Input: "1l", ParseOptions: crockford, ExpectedError: safecast.ErrStringConversion},
+		"crockford hyphen":         MapTestParse[uint64]{Input: "3NQ-K8N", ParseOptions: crockford, ExpectedError: safecast.ErrStringConversion},
+		"excluded letter":          MapTestParse[uint64]{Input: "3NQK8U", ParseOptions: crockford, ExpectedError: safecast.ErrStringConversion, ErrorContains: "cannot convert from `3NQK8U` to uint64 (base 32)"},
+		"empty":                    MapTestParse[uint64]{Input: "", ParseOptions: base58, ExpectedError: safecast.ErrStringConversion},
+		"only sign":                MapTestParse[int64]{Input: "-", ParseOptions: base58, ExpectedError: safecast.ErrStringConversion},
+		"plus sign":                MapTestParse[int64]{Input: "+BukQL", ParseOptions: base58, ExpectedError: safecast.ErrStringConversion},
+		"overflow":                 MapTestParse[uint64]{Input: "G000000000000", ParseOptions: crockford, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "G000000000000 (string)"},
+		"huge overflow":            MapTestParse[int64]{Input: "-ZZZZZZZZZZZZZZZZZZZZZZZZZZZZZZ", ParseOptions: crockford, ExpectedError: safecast.ErrExceedMinimumValue},
+		"invalid after overflow":   MapTestParse[uint64]{Input: "ZZZZZZZZZZZZZZZZZZZZZZZZZZZZZZU", ParseOptions: crockford, ExpectedError: safecast.ErrStringConversion},
+		"negative to unsigned":     MapTestParse[uint64]{Input: "-2", ParseOptions: base58, ExpectedError: safecast.ErrExceedMinimumValue},
+		"out of range":             MapTestParse[uint8]{Input: "BukQL", ParseOptions: base58, ExpectedError: safecast.ErrExceedMaximumValue},
+		"single digit alphabet":    MapTestParse[uint8]{Input: "0", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("0")}, ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid alphabet"},
+		"duplicated digits":        MapTestParse[uint8]{Input: "0", ParseOptions: []safecast.ParseOption{safecast.WithAlphabet("010")}, ExpectedError: safecast.ErrStringConversion, ErrorContains: "invalid alphabet"},
+		"strict leading zero":      MapTestParse[uint64]{Input: "11BukQL", ParseOptions: append(base58, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"strict negative zero":     MapTestParse[int64]{Input: "-0", ParseOptions: append(crockford, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "negative zero"},
+		"strict":                   MapTestParse[uint64]{Input: "BukQL", ParseOptions: append(base58, safecast.WithStrict()), ExpectedOutput: 123456789},
+		"strict alphabet case":     MapTestParse[uint64]{Input: "ZZ", ParseOptions: append(crockford, safecast.WithStrict()), ExpectedOutput: 1023},
+		"strict other case":        MapTestParse[uint64]{Input: "zz", ParseOptions: append(crockford, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "letter case"},
+		"strict mixed case":        MapTestParse[uint64]{Input: "Zz", ParseOptions: append(crockford, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "letter case"},
+		"strict lowercase alphabet": MapTestParse[uint64]{
+			Input:         "Z",
+			ParseOptions:  []safecast.ParseOption{safecast.WithAlphabet(safecast.AlphabetBase36), safecast.WithStrict()},
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "letter case",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleWithBase() {
+	i, err := safecast.Parse[uint64]("zik0zj", safecast.WithBase(36))
+	fmt.Println(i, err)
+
+	_, err = safecast.Parse[uint64]("zz", safecast.WithBase(32))
+	fmt.Println(err)
+
+	// Output:
+	// 2147483647 <nil>
+	// conversion issue: cannot convert from `zz` to uint64 (base 32)
+}
+
+func ExampleWithAlphabet() {
+	i, err := safecast.Parse[uint64]("3nqk8n", safecast.WithAlphabet(safecast.AlphabetCrockford))
+	fmt.Println(i, err)
+
+	i, err = safecast.Parse[uint64]("BukQL", safecast.WithAlphabet(safecast.AlphabetBase58))
+	fmt.Println(i, err)
+
+	_, err = safecast.Parse[uint64]("0OIl", safecast.WithAlphabet(safecast.AlphabetBase58))
+	fmt.Println(err)
+
+	// Output:
+	// 123456789 <nil>
+	// 123456789 <nil>
+	// conversion issue: cannot convert from `0OIl` to uint64 (base 58)
+}
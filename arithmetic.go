@@ -0,0 +1,494 @@
+package safecast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Add returns the sum of a and b, and reports an error if the result overflows T.
+//
+// # Errors when the result exceeds the range of T, the following errors are wrapped in the returned error:
+//
+//   - [ErrRangeOverflow] when the result is outside the range of T (example: 100 + 100 with int8).
+//   - [ErrExceedMaximumValue] when the result exceeds the maximum value of T (example: 255 + 1 with uint8).
+//   - [ErrExceedMinimumValue] when the result is less than the minimum value of T (example: -128 + -1 with int8).
+//
+// # Errors specific to floating-point types:
+//
+//   - [ErrRangeOverflow] is also reported when the result is an infinity.
+//   - [ErrUnsupportedConversion] when the result is [math.NaN].
+//
+// [ErrConversionIssue] is always wrapped in the returned error when [Add] fails.
+//
+// When an error is returned, the result is the one of the unchecked Go operation.
+func Add[T Number](a, b T) (T, error) {
+	result := a + b
+	if isFloat[T]() {
+		return checkFloatResult(result, a, "+", b)
+	}
+
+	switch {
+	case b > 0 && result < a:
+		return result, binaryOperationError[T](ErrExceedMaximumValue, a, "+", b)
+	case b < 0 && result > a:
+		return result, binaryOperationError[T](ErrExceedMinimumValue, a, "+", b)
+	}
+	return result, nil
+}
+
+// Sub returns the difference of a and b, and reports an error if the result overflows T.
+//
+// The errors are the same as the ones reported by [Add] (example: 0 - 1 with uint8 exceeds the minimum value).
+func Sub[T Number](a, b T) (T, error) {
+	result := a - b
+	if isFloat[T]() {
+		return checkFloatResult(result, a, "-", b)
+	}
+
+	switch {
+	case b > 0 && result > a:
+		return result, binaryOperationError[T](ErrExceedMinimumValue, a, "-", b)
+	case b < 0 && result < a:
+		return result, binaryOperationError[T](ErrExceedMaximumValue, a, "-", b)
+	}
+	return result, nil
+}
+
+// Mul returns the product of a and b, and reports an error if the result overflows T.
+//
+// The errors are the same as the ones reported by [Add] (example: 16 * 16 with uint8 exceeds the maximum value).
+func Mul[T Number](a, b T) (T, error) {
+	result := a * b
+	if isFloat[T]() {
+		return checkFloatResult(result, a, "*", b)
+	}
+
+	if a == 0 || b == 0 {
+		return result, nil
+	}
+
+	negative := isNegative(a) != isNegative(b)
+	// the sign check catches math.MinInt64 * -1, as math.MinInt64 / -1 gives math.MinInt64 in Go
+	if result/b != a || isNegative(result) != negative {
+		err := ErrExceedMaximumValue
+		if negative {
+			err = ErrExceedMinimumValue
+		}
+		return result, binaryOperationError[T](err, a, "*", b)
+	}
+	return result, nil
+}
+
+// Div returns the quotient of a and b, and reports an error if the division is not possible.
+//
+// For integers, the quotient is truncated toward zero, like Go does.
+//
+// # Errors
+//
+//   - [ErrDivisionByZero] when b is zero, instead of the panic or the infinity you would get with Go.
+//   - [ErrExceedMaximumValue] and [ErrRangeOverflow] when the result overflows T (example: -128 / -1 with int8).
+//   - [ErrUnsupportedConversion] when the result of a floating-point division is [math.NaN].
+//
+// [ErrConversionIssue] is always wrapped in the returned error when [Div] fails.
+func Div[T Number](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOperationError[T](ErrDivisionByZero, a, "/", b)
+	}
+
+	result := a / b
+	if isFloat[T]() {
+		return checkFloatResult(result, a, "/", b)
+	}
+
+	if isMinusOne(b) && a == minValue[T]() {
+		return result, binaryOperationError[T](ErrExceedMaximumValue, a, "/", b)
+	}
+	return result, nil
+}
+
+// Mod returns the remainder of a divided by b, and reports an error if the division is not possible.
+//
+// The remainder has the sign of a, like the Go % operator does for integers.
+// For floating-point types, [math.Mod] is used.
+//
+// # Errors
+//
+//   - [ErrDivisionByZero] when b is zero.
+//   - [ErrUnsupportedConversion] when the result of a floating-point operation is [math.NaN] (example: [math.Inf] % 2).
+//
+// [ErrConversionIssue] is always wrapped in the returned error when [Mod] fails.
+func Mod[T Number](a, b T) (T, error) {
+	if b == 0 {
+		return 0, binaryOperationError[T](ErrDivisionByZero, a, "%", b)
+	}
+
+	if isFloat[T]() {
+		result := T(math.Mod(float64(a), float64(b)))
+		return checkFloatResult(result, a, "%", b)
+	}
+
+	// the % operator is not available for the Number constraint, as it includes floating-point types.
+	// math.MinInt64 / -1 gives math.MinInt64 in Go, so the remainder is 0 as expected
+	return a - (a/b)*b, nil
+}
+
+// Neg returns the negation of a, and reports an error if the result overflows T.
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrRangeOverflow] when a is the minimum value of a signed type (example: -128 with int8).
+//   - [ErrExceedMinimumValue] and [ErrRangeOverflow] when a is not zero for an unsigned type (example: 1 with uint8).
+//   - [ErrRangeOverflow] or [ErrUnsupportedConversion] when a is [math.Inf] or [math.NaN].
+//
+// [ErrConversionIssue] is always wrapped in the returned error when [Neg] fails.
+func Neg[T Number](a T) (T, error) {
+	result := -a
+	if isFloat[T]() {
+		return checkFloatUnaryResult(result, "negation of", a)
+	}
+
+	switch {
+	case isUnsigned[T]() && a != 0:
+		return result, unaryOperationError[T](ErrExceedMinimumValue, "negation of", a)
+	case !isUnsigned[T]() && a == minValue[T]():
+		return result, unaryOperationError[T](ErrExceedMaximumValue, "negation of", a)
+	}
+	return result, nil
+}
+
+// Abs returns the absolute value of a, and reports an error if the result overflows T.
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrRangeOverflow] when a is the minimum value of a signed type (example: -128 with int8).
+//   - [ErrRangeOverflow] or [ErrUnsupportedConversion] when a is [math.Inf] or [math.NaN].
+//
+// [ErrConversionIssue] is always wrapped in the returned error when [Abs] fails.
+func Abs[T Number](a T) (T, error) {
+	result := a
+	if isNegative(a) {
+		result = -a
+	}
+
+	if isFloat[T]() {
+		return checkFloatUnaryResult(result, "absolute value of", a)
+	}
+
+	if isNegative(result) {
+		// only the minimum value of a signed type remains negative
+		return result, unaryOperationError[T](ErrExceedMaximumValue, "absolute value of", a)
+	}
+	return result, nil
+}
+
+// AddAs returns the sum of a and b, which may be of different types, converted to Out.
+//
+// The sum is computed on the mathematically exact values, so no intermediate conversion can overflow:
+// it only fails if the result does not fit in Out, with the same errors as [Convert].
+//
+// Example:
+//
+//	end, err := AddAs[int](offset, length) // offset is an int64, and length an uint32
+//
+// When a and b are both floating-point types, the sum is computed with float64 arithmetic, as Go would do.
+// When only one of them is, the sum is still computed on the exact values, so an integer beyond 2^53
+// is not rounded (example: AddAs[int64](int64(1<<53+1), 0.0) gives 1<<53+1).
+// The infinities and [math.NaN] are the exceptions, as they have no exact value.
+//
+// When an error is returned, the result is zero.
+func AddAs[Out Number, A Number, B Number](a A, b B) (Out, error) {
+	switch {
+	case isFloat[A]() && isFloat[B]() || !isFinite(a) || !isFinite(b):
+		return convertOperationResult[Out](float64(a)+float64(b), a, "+", b)
+	case isFloat[A]() || isFloat[B]() || isFloat[Out]():
+		return convertOperationRat[Out](new(big.Rat).Add(newExactRat(a), newExactRat(b)), a, "+", b)
+	}
+	return convertExactInt[Out](newExactInt(a).add(newExactInt(b)), a, "+", b)
+}
+
+// SubAs returns the difference of a and b, which may be of different types, converted to Out.
+//
+// It works the same way as [AddAs] (example: SubAs[uint8](int64(300), uint16(100)) gives 200).
+func SubAs[Out Number, A Number, B Number](a A, b B) (Out, error) {
+	switch {
+	case isFloat[A]() && isFloat[B]() || !isFinite(a) || !isFinite(b):
+		return convertOperationResult[Out](float64(a)-float64(b), a, "-", b)
+	case isFloat[A]() || isFloat[B]() || isFloat[Out]():
+		return convertOperationRat[Out](new(big.Rat).Sub(newExactRat(a), newExactRat(b)), a, "-", b)
+	}
+	return convertExactInt[Out](newExactInt(a).add(newExactInt(b).neg()), a, "-", b)
+}
+
+// MulAs returns the product of a and b, which may be of different types, converted to Out.
+//
+// It works the same way as [AddAs] (example: MulAs[int32](int64(1<<20), uint8(2)) gives 2097152).
+func MulAs[Out Number, A Number, B Number](a A, b B) (Out, error) {
+	switch {
+	case isFloat[A]() && isFloat[B]() || !isFinite(a) || !isFinite(b):
+		return convertOperationResult[Out](float64(a)*float64(b), a, "*", b)
+	case isFloat[A]() || isFloat[B]() || isFloat[Out]():
+		return convertOperationRat[Out](new(big.Rat).Mul(newExactRat(a), newExactRat(b)), a, "*", b)
+	}
+	return convertExactInt[Out](newExactInt(a).mul(newExactInt(b)), a, "*", b)
+}
+
+// MustAdd calls [Add] and panics if the operation fails.
+func MustAdd[T Number](a, b T) T {
+	return mustOperate(Add(a, b))
+}
+
+// MustSub calls [Sub] and panics if the operation fails.
+func MustSub[T Number](a, b T) T {
+	return mustOperate(Sub(a, b))
+}
+
+// MustMul calls [Mul] and panics if the operation fails.
+func MustMul[T Number](a, b T) T {
+	return mustOperate(Mul(a, b))
+}
+
+// MustDiv calls [Div] and panics if the operation fails.
+func MustDiv[T Number](a, b T) T {
+	return mustOperate(Div(a, b))
+}
+
+// MustMod calls [Mod] and panics if the operation fails.
+func MustMod[T Number](a, b T) T {
+	return mustOperate(Mod(a, b))
+}
+
+// MustNeg calls [Neg] and panics if the operation fails.
+func MustNeg[T Number](a T) T {
+	return mustOperate(Neg(a))
+}
+
+// MustAbs calls [Abs] and panics if the operation fails.
+func MustAbs[T Number](a T) T {
+	return mustOperate(Abs(a))
+}
+
+// RequireAdd is a test helper that calls [Add], and fails the test if the operation fails.
+func RequireAdd[T Number](t TestingT, a, b T) T {
+	t.Helper()
+	result, err := Add(a, b)
+	return requireOperate(t, result, err)
+}
+
+// RequireSub is a test helper that calls [Sub], and fails the test if the operation fails.
+func RequireSub[T Number](t TestingT, a, b T) T {
+	t.Helper()
+	result, err := Sub(a, b)
+	return requireOperate(t, result, err)
+}
+
+// RequireMul is a test helper that calls [Mul], and fails the test if the operation fails.
+func RequireMul[T Number](t TestingT, a, b T) T {
+	t.Helper()
+	result, err := Mul(a, b)
+	return requireOperate(t, result, err)
+}
+
+// RequireDiv is a test helper that calls [Div], and fails the test if the operation fails.
+func RequireDiv[T Number](t TestingT, a, b T) T {
+	t.Helper()
+	result, err := Div(a, b)
+	return requireOperate(t, result, err)
+}
+
+// RequireMod is a test helper that calls [Mod], and fails the test if the operation fails.
+func RequireMod[T Number](t TestingT, a, b T) T {
+	t.Helper()
+	result, err := Mod(a, b)
+	return requireOperate(t, result, err)
+}
+
+// RequireNeg is a test helper that calls [Neg], and fails the test if the operation fails.
+func RequireNeg[T Number](t TestingT, a T) T {
+	t.Helper()
+	result, err := Neg(a)
+	return requireOperate(t, result, err)
+}
+
+// RequireAbs is a test helper that calls [Abs], and fails the test if the operation fails.
+func RequireAbs[T Number](t TestingT, a T) T {
+	t.Helper()
+	result, err := Abs(a)
+	return requireOperate(t, result, err)
+}
+
+func mustOperate[T Number](result T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func requireOperate[T Number](t TestingT, result T, err error) T {
+	t.Helper()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func isMinusOne[T Number](v T) bool {
+	return isNegative(v) && v+1 == 0
+}
+
+// checkFloatResult reports infinities and NaN obtained when operating on floating-point values.
+func checkFloatResult[T Number, A Number, B Number](result T, a A, operator string, b B) (T, error) {
+	if err := floatResultError(result); err != nil {
+		return result, binaryOperationError[T](err, a, operator, b)
+	}
+	return result, nil
+}
+
+// checkFloatUnaryResult is the unary counterpart of [checkFloatResult].
+func checkFloatUnaryResult[T Number](result T, operation string, a T) (T, error) {
+	if err := floatResultError(result); err != nil {
+		return result, unaryOperationError[T](err, operation, a)
+	}
+	return result, nil
+}
+
+func floatResultError[T Number](result T) error {
+	f := float64(result)
+	switch {
+	case math.IsNaN(f):
+		return ErrUnsupportedConversion
+	case math.IsInf(f, 1):
+		return ErrExceedMaximumValue
+	case math.IsInf(f, -1):
+		return ErrExceedMinimumValue
+	}
+	return nil
+}
+
+func binaryOperationError[NumOut Number, A Number, B Number](err error, a A, operator string, b B) error {
+	e := newConversionError[NumOut](nil, err)
+	e.Operation = fmt.Sprintf("%v (%T) %s %v (%T)", a, a, operator, b, b)
+	return e
+}
+
+func unaryOperationError[NumOut Number, A Number](err error, operation string, a A) error {
+	e := newConversionError[NumOut](nil, err)
+	e.Operation = fmt.Sprintf("%s %v (%T)", operation, a, a)
+	return e
+}
+
+// exactInt is a 128-bit integer stored as a sign and a magnitude.
+//
+// It can hold the exact result of adding, subtracting, or multiplying any integers up to 64 bits.
+type exactInt struct {
+	negative bool
+	hi, lo   uint64
+}
+
+func newExactInt[T Number](v T) exactInt {
+	if isNegative(v) {
+		// the negation is done on uint64, so math.MinInt64 gives 1<<63 as expected
+		return exactInt{negative: true, lo: -uint64(int64(v))}
+	}
+	return exactInt{lo: uint64(v)}
+}
+
+func (x exactInt) isZero() bool {
+	return x.hi == 0 && x.lo == 0
+}
+
+func (x exactInt) neg() exactInt {
+	x.negative = !x.negative && !x.isZero()
+	return x
+}
+
+// less compares the magnitudes of x and y.
+func (x exactInt) less(y exactInt) bool {
+	return x.hi < y.hi || (x.hi == y.hi && x.lo < y.lo)
+}
+
+func (x exactInt) add(y exactInt) exactInt {
+	if x.negative == y.negative {
+		lo, carry := bits.Add64(x.lo, y.lo, 0)
+		hi, _ := bits.Add64(x.hi, y.hi, carry)
+		return exactInt{negative: x.negative, hi: hi, lo: lo}
+	}
+
+	if x.less(y) {
+		x, y = y, x
+	}
+	lo, borrow := bits.Sub64(x.lo, y.lo, 0)
+	hi, _ := bits.Sub64(x.hi, y.hi, borrow)
+	result := exactInt{negative: x.negative, hi: hi, lo: lo}
+	result.negative = result.negative && !result.isZero()
+	return result
+}
+
+// mul multiplies x and y, their magnitudes must fit in 64 bits.
+func (x exactInt) mul(y exactInt) exactInt {
+	hi, lo := bits.Mul64(x.lo, y.lo)
+	result := exactInt{negative: x.negative != y.negative, hi: hi, lo: lo}
+	result.negative = result.negative && !result.isZero()
+	return result
+}
+
+func convertExactInt[Out Number, A Number, B Number](x exactInt, a A, operator string, b B) (Out, error) {
+	switch {
+	case x.hi != 0 && x.negative:
+		return 0, binaryOperationError[Out](ErrExceedMinimumValue, a, operator, b)
+	case x.hi != 0:
+		return 0, binaryOperationError[Out](ErrExceedMaximumValue, a, operator, b)
+	case !x.negative:
+		return convertOperationResult[Out](x.lo, a, operator, b)
+	case x.lo > 1<<63:
+		return 0, binaryOperationError[Out](ErrExceedMinimumValue, a, operator, b)
+	}
+	// the negation is done on uint64, so 1<<63 gives math.MinInt64 as expected
+	return convertOperationResult[Out](int64(-x.lo), a, operator, b)
+}
+
+// convertOperationResult converts the result of an operation to Out,
+// the errors reported by [Convert] are replaced to refer to the operation.
+func convertOperationResult[Out Number, NumIn Number, A Number, B Number](result NumIn, a A, operator string, b B) (Out, error) {
+	converted, err := Convert[Out](result)
+	if err == nil {
+		return converted, nil
+	}
+	return 0, operationResultError[Out](err, a, operator, b)
+}
+
+// convertOperationRat is the counterpart of [convertOperationResult] for the exact results, see [ConvertFromBigRat].
+func convertOperationRat[Out Number, A Number, B Number](result *big.Rat, a A, operator string, b B) (Out, error) {
+	converted, err := ConvertFromBigRat[Out](result)
+	if err == nil {
+		return converted, nil
+	}
+	return 0, operationResultError[Out](err, a, operator, b)
+}
+
+// operationResultError replaces the error of the conversion of the result of an operation, to refer to the operation.
+func operationResultError[Out Number, A Number, B Number](err error, a A, operator string, b B) error {
+	for _, errConvert := range []error{ErrExceedMaximumValue, ErrExceedMinimumValue, ErrUnsupportedConversion} {
+		if errors.Is(err, errConvert) {
+			return binaryOperationError[Out](errConvert, a, operator, b)
+		}
+	}
+	return err
+}
+
+// newExactRat returns the exact value of v, that must not be an infinity or [math.NaN].
+func newExactRat[T Number](v T) *big.Rat {
+	if isFloat[T]() {
+		return new(big.Rat).SetFloat64(float64(v))
+	}
+	return new(big.Rat).SetInt(ToBigInt(v))
+}
+
+// isFinite reports whether v is neither an infinity nor [math.NaN], which is always true for the integers.
+func isFinite[T Number](v T) bool {
+	f := float64(v)
+	return !math.IsInf(f, 0) && !math.IsNaN(f)
+}
@@ -0,0 +1,561 @@
+package safecast_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+type MapArithmeticTest[T safecast.Number] struct {
+	Operation      func(a, b T) (T, error)
+	A, B           T
+	ExpectedOutput T
+	ExpectedError  error
+	ErrorContains  string
+}
+
+func (mt MapArithmeticTest[T]) Run(t *testing.T) {
+	t.Helper()
+
+	out, err := mt.Operation(mt.A, mt.B)
+	if mt.ExpectedError != nil {
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, mt.ExpectedError)
+
+		if mt.ErrorContains != "" {
+			requireErrorContains(t, err, mt.ErrorContains)
+		}
+
+		return
+	}
+
+	assertNoError(t, err)
+	assertEqual(t, mt.ExpectedOutput, out)
+}
+
+// unary adapts a unary operation so it can be used with [MapArithmeticTest]
+func unary[T safecast.Number](fn func(a T) (T, error)) func(a, _ T) (T, error) {
+	return func(a, _ T) (T, error) {
+		return fn(a)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":                 MapArithmeticTest[int]{Operation: safecast.Add[int], A: 40, B: 2, ExpectedOutput: 42},
+		"int8 near max":       MapArithmeticTest[int8]{Operation: safecast.Add[int8], A: 100, B: 27, ExpectedOutput: math.MaxInt8},
+		"int8 near min":       MapArithmeticTest[int8]{Operation: safecast.Add[int8], A: -100, B: -28, ExpectedOutput: math.MinInt8},
+		"int8 mixed signs":    MapArithmeticTest[int8]{Operation: safecast.Add[int8], A: math.MinInt8, B: math.MaxInt8, ExpectedOutput: -1},
+		"uint8 near max":      MapArithmeticTest[uint8]{Operation: safecast.Add[uint8], A: 200, B: 55, ExpectedOutput: math.MaxUint8},
+		"uint64":              MapArithmeticTest[uint64]{Operation: safecast.Add[uint64], A: math.MaxUint64 - 1, B: 1, ExpectedOutput: math.MaxUint64},
+		"float64":             MapArithmeticTest[float64]{Operation: safecast.Add[float64], A: 0.5, B: 0.25, ExpectedOutput: 0.75},
+		"float32":             MapArithmeticTest[float32]{Operation: safecast.Add[float32], A: 0.5, B: 0.25, ExpectedOutput: 0.75},
+		"float32 near max":    MapArithmeticTest[float32]{Operation: safecast.Add[float32], A: math.MaxFloat32, B: 1, ExpectedOutput: math.MaxFloat32},
+		"uintptr":             MapArithmeticTest[uintptr]{Operation: safecast.Add[uintptr], A: 40, B: 2, ExpectedOutput: 42},
+		"int8 overflows max":  MapArithmeticTest[int8]{Operation: safecast.Add[int8], A: 100, B: 28, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "100 (int8) + 28 (int8) is greater than 127 (int8)"},
+		"int8 overflows min":  MapArithmeticTest[int8]{Operation: safecast.Add[int8], A: -100, B: -29, ExpectedError: safecast.ErrExceedMinimumValue, ErrorContains: "-100 (int8) + -29 (int8) is less than -128 (int8)"},
+		"uint8 overflows max": MapArithmeticTest[uint8]{Operation: safecast.Add[uint8], A: 255, B: 1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 overflows max": MapArithmeticTest[int64]{Operation: safecast.Add[int64], A: math.MaxInt64, B: 1, ExpectedError: safecast.ErrRangeOverflow},
+		"int64 overflows min": MapArithmeticTest[int64]{Operation: safecast.Add[int64], A: math.MinInt64, B: -1, ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 overflows":   MapArithmeticTest[float64]{Operation: safecast.Add[float64], A: math.MaxFloat64, B: math.MaxFloat64, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float32 overflows":   MapArithmeticTest[float32]{Operation: safecast.Add[float32], A: -math.MaxFloat32, B: -math.MaxFloat32, ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 Inf":         MapArithmeticTest[float64]{Operation: safecast.Add[float64], A: math.Inf(1), B: 1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float64 NaN":         MapArithmeticTest[float64]{Operation: safecast.Add[float64], A: math.NaN(), B: 1, ExpectedError: safecast.ErrUnsupportedConversion, ErrorContains: "NaN (float64) + 1 (float64) is not supported"},
+		"float64 Inf - Inf":   MapArithmeticTest[float64]{Operation: safecast.Add[float64], A: math.Inf(1), B: math.Inf(-1), ExpectedError: safecast.ErrUnsupportedConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestSub(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":                 MapArithmeticTest[int]{Operation: safecast.Sub[int], A: 44, B: 2, ExpectedOutput: 42},
+		"int8 near min":       MapArithmeticTest[int8]{Operation: safecast.Sub[int8], A: -100, B: 28, ExpectedOutput: math.MinInt8},
+		"int8 near max":       MapArithmeticTest[int8]{Operation: safecast.Sub[int8], A: 100, B: -27, ExpectedOutput: math.MaxInt8},
+		"uint8 to zero":       MapArithmeticTest[uint8]{Operation: safecast.Sub[uint8], A: 42, B: 42, ExpectedOutput: 0},
+		"float64":             MapArithmeticTest[float64]{Operation: safecast.Sub[float64], A: 0.5, B: 0.25, ExpectedOutput: 0.25},
+		"uint8 below zero":    MapArithmeticTest[uint8]{Operation: safecast.Sub[uint8], A: 0, B: 1, ExpectedError: safecast.ErrExceedMinimumValue, ErrorContains: "0 (uint8) - 1 (uint8) is less than 0 (uint8)"},
+		"uint64 below zero":   MapArithmeticTest[uint64]{Operation: safecast.Sub[uint64], A: 1, B: math.MaxUint64, ExpectedError: safecast.ErrExceedMinimumValue},
+		"int8 overflows min":  MapArithmeticTest[int8]{Operation: safecast.Sub[int8], A: -100, B: 29, ExpectedError: safecast.ErrExceedMinimumValue},
+		"int8 overflows max":  MapArithmeticTest[int8]{Operation: safecast.Sub[int8], A: 0, B: math.MinInt8, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 overflows max": MapArithmeticTest[int64]{Operation: safecast.Sub[int64], A: math.MaxInt64, B: -1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float64 overflows":   MapArithmeticTest[float64]{Operation: safecast.Sub[float64], A: -math.MaxFloat64, B: math.MaxFloat64, ExpectedError: safecast.ErrExceedMinimumValue},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":                       MapArithmeticTest[int]{Operation: safecast.Mul[int], A: 6, B: 7, ExpectedOutput: 42},
+		"int by zero":               MapArithmeticTest[int]{Operation: safecast.Mul[int], A: math.MaxInt, B: 0, ExpectedOutput: 0},
+		"zero by int":               MapArithmeticTest[int]{Operation: safecast.Mul[int], A: 0, B: math.MinInt, ExpectedOutput: 0},
+		"int8 negative":             MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: -64, B: 2, ExpectedOutput: math.MinInt8},
+		"int8 minus one":            MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: math.MaxInt8, B: -1, ExpectedOutput: -math.MaxInt8},
+		"uint8 near max":            MapArithmeticTest[uint8]{Operation: safecast.Mul[uint8], A: 15, B: 17, ExpectedOutput: math.MaxUint8},
+		"float64":                   MapArithmeticTest[float64]{Operation: safecast.Mul[float64], A: 0.5, B: 0.5, ExpectedOutput: 0.25},
+		"uint8 overflows":           MapArithmeticTest[uint8]{Operation: safecast.Mul[uint8], A: 16, B: 16, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "16 (uint8) * 16 (uint8) is greater than 255 (uint8)"},
+		"int8 overflows max":        MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: -64, B: -2, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int8 overflows min":        MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: 64, B: -3, ExpectedError: safecast.ErrExceedMinimumValue},
+		"int8 min by minus one":     MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: math.MinInt8, B: -1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int8 minus one by min":     MapArithmeticTest[int8]{Operation: safecast.Mul[int8], A: -1, B: math.MinInt8, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 min by minus one":    MapArithmeticTest[int64]{Operation: safecast.Mul[int64], A: math.MinInt64, B: -1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 overflows to sign":   MapArithmeticTest[int64]{Operation: safecast.Mul[int64], A: math.MaxInt64 / 2, B: 3, ExpectedError: safecast.ErrExceedMaximumValue},
+		"uint64 overflows":          MapArithmeticTest[uint64]{Operation: safecast.Mul[uint64], A: 1 << 32, B: 1 << 32, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float32 overflows":         MapArithmeticTest[float32]{Operation: safecast.Mul[float32], A: math.MaxFloat32, B: -2, ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 zero by Inf (NaN)": MapArithmeticTest[float64]{Operation: safecast.Mul[float64], A: 0, B: math.Inf(1), ExpectedError: safecast.ErrUnsupportedConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestDiv(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":                  MapArithmeticTest[int]{Operation: safecast.Div[int], A: 84, B: 2, ExpectedOutput: 42},
+		"int truncated":        MapArithmeticTest[int]{Operation: safecast.Div[int], A: -7, B: 2, ExpectedOutput: -3},
+		"int8 min by one":      MapArithmeticTest[int8]{Operation: safecast.Div[int8], A: math.MinInt8, B: 1, ExpectedOutput: math.MinInt8},
+		"int8 max by minus":    MapArithmeticTest[int8]{Operation: safecast.Div[int8], A: math.MaxInt8, B: -1, ExpectedOutput: -math.MaxInt8},
+		"uint8":                MapArithmeticTest[uint8]{Operation: safecast.Div[uint8], A: 255, B: 255, ExpectedOutput: 1},
+		"float64":              MapArithmeticTest[float64]{Operation: safecast.Div[float64], A: 1, B: 4, ExpectedOutput: 0.25},
+		"int by zero":          MapArithmeticTest[int]{Operation: safecast.Div[int], A: 42, B: 0, ExpectedError: safecast.ErrDivisionByZero, ErrorContains: "42 (int) / 0 (int): division by zero"},
+		"uint8 by zero":        MapArithmeticTest[uint8]{Operation: safecast.Div[uint8], A: 42, B: 0, ExpectedError: safecast.ErrDivisionByZero},
+		"float64 by zero":      MapArithmeticTest[float64]{Operation: safecast.Div[float64], A: 42, B: 0, ExpectedError: safecast.ErrDivisionByZero},
+		"int8 min by minus":    MapArithmeticTest[int8]{Operation: safecast.Div[int8], A: math.MinInt8, B: -1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 min by minus":   MapArithmeticTest[int64]{Operation: safecast.Div[int64], A: math.MinInt64, B: -1, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float64 overflows":    MapArithmeticTest[float64]{Operation: safecast.Div[float64], A: math.MaxFloat64, B: 0.5, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float32 overflows":    MapArithmeticTest[float32]{Operation: safecast.Div[float32], A: -math.MaxFloat32, B: 0.5, ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 Inf by Inf":   MapArithmeticTest[float64]{Operation: safecast.Div[float64], A: math.Inf(1), B: math.Inf(1), ExpectedError: safecast.ErrUnsupportedConversion},
+		"float64 negative one": MapArithmeticTest[float64]{Operation: safecast.Div[float64], A: -math.MaxFloat64, B: -1, ExpectedOutput: math.MaxFloat64},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestMod(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":               MapArithmeticTest[int]{Operation: safecast.Mod[int], A: 7, B: 3, ExpectedOutput: 1},
+		"negative int":      MapArithmeticTest[int]{Operation: safecast.Mod[int], A: -7, B: 3, ExpectedOutput: -1},
+		"int8 min by minus": MapArithmeticTest[int8]{Operation: safecast.Mod[int8], A: math.MinInt8, B: -1, ExpectedOutput: 0},
+		"uint8":             MapArithmeticTest[uint8]{Operation: safecast.Mod[uint8], A: 255, B: 16, ExpectedOutput: 15},
+		"float64":           MapArithmeticTest[float64]{Operation: safecast.Mod[float64], A: 7.5, B: 2, ExpectedOutput: 1.5},
+		"float32":           MapArithmeticTest[float32]{Operation: safecast.Mod[float32], A: -7.5, B: 2, ExpectedOutput: -1.5},
+		"int by zero":       MapArithmeticTest[int]{Operation: safecast.Mod[int], A: 42, B: 0, ExpectedError: safecast.ErrDivisionByZero, ErrorContains: "42 (int) % 0 (int): division by zero"},
+		"float64 by zero":   MapArithmeticTest[float64]{Operation: safecast.Mod[float64], A: 42, B: 0, ExpectedError: safecast.ErrDivisionByZero},
+		"float64 Inf":       MapArithmeticTest[float64]{Operation: safecast.Mod[float64], A: math.Inf(1), B: 2, ExpectedError: safecast.ErrUnsupportedConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestNeg(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int":             MapArithmeticTest[int]{Operation: unary(safecast.Neg[int]), A: 42, ExpectedOutput: -42},
+		"int8 max":        MapArithmeticTest[int8]{Operation: unary(safecast.Neg[int8]), A: math.MaxInt8, ExpectedOutput: -math.MaxInt8},
+		"uint8 zero":      MapArithmeticTest[uint8]{Operation: unary(safecast.Neg[uint8]), A: 0, ExpectedOutput: 0},
+		"float64":         MapArithmeticTest[float64]{Operation: unary(safecast.Neg[float64]), A: -0.5, ExpectedOutput: 0.5},
+		"float32 max":     MapArithmeticTest[float32]{Operation: unary(safecast.Neg[float32]), A: math.MaxFloat32, ExpectedOutput: -math.MaxFloat32},
+		"int8 min":        MapArithmeticTest[int8]{Operation: unary(safecast.Neg[int8]), A: math.MinInt8, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "negation of -128 (int8) is greater than 127 (int8)"},
+		"int64 min":       MapArithmeticTest[int64]{Operation: unary(safecast.Neg[int64]), A: math.MinInt64, ExpectedError: safecast.ErrExceedMaximumValue},
+		"uint8 positive":  MapArithmeticTest[uint8]{Operation: unary(safecast.Neg[uint8]), A: 1, ExpectedError: safecast.ErrExceedMinimumValue, ErrorContains: "negation of 1 (uint8) is less than 0 (uint8)"},
+		"uintptr":         MapArithmeticTest[uintptr]{Operation: unary(safecast.Neg[uintptr]), A: 1, ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 Inf":     MapArithmeticTest[float64]{Operation: unary(safecast.Neg[float64]), A: math.Inf(1), ExpectedError: safecast.ErrExceedMinimumValue},
+		"float64 NaN":     MapArithmeticTest[float64]{Operation: unary(safecast.Neg[float64]), A: math.NaN(), ExpectedError: safecast.ErrUnsupportedConversion},
+		"float32 NaN":     MapArithmeticTest[float32]{Operation: unary(safecast.Neg[float32]), A: float32(math.NaN()), ExpectedError: safecast.ErrUnsupportedConversion},
+		"float32 negated": MapArithmeticTest[float32]{Operation: unary(safecast.Neg[float32]), A: float32(math.Inf(-1)), ExpectedError: safecast.ErrExceedMaximumValue},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestAbs(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"positive int":     MapArithmeticTest[int]{Operation: unary(safecast.Abs[int]), A: 42, ExpectedOutput: 42},
+		"negative int":     MapArithmeticTest[int]{Operation: unary(safecast.Abs[int]), A: -42, ExpectedOutput: 42},
+		"int8 near min":    MapArithmeticTest[int8]{Operation: unary(safecast.Abs[int8]), A: math.MinInt8 + 1, ExpectedOutput: math.MaxInt8},
+		"uint8":            MapArithmeticTest[uint8]{Operation: unary(safecast.Abs[uint8]), A: math.MaxUint8, ExpectedOutput: math.MaxUint8},
+		"float64":          MapArithmeticTest[float64]{Operation: unary(safecast.Abs[float64]), A: -0.5, ExpectedOutput: 0.5},
+		"float32":          MapArithmeticTest[float32]{Operation: unary(safecast.Abs[float32]), A: -math.MaxFloat32, ExpectedOutput: math.MaxFloat32},
+		"int8 min":         MapArithmeticTest[int8]{Operation: unary(safecast.Abs[int8]), A: math.MinInt8, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "absolute value of -128 (int8) is greater than 127 (int8)"},
+		"int64 min":        MapArithmeticTest[int64]{Operation: unary(safecast.Abs[int64]), A: math.MinInt64, ExpectedError: safecast.ErrExceedMaximumValue},
+		"float64 -Inf":     MapArithmeticTest[float64]{Operation: unary(safecast.Abs[float64]), A: math.Inf(-1), ExpectedError: safecast.ErrExceedMaximumValue},
+		"float64 NaN":      MapArithmeticTest[float64]{Operation: unary(safecast.Abs[float64]), A: math.NaN(), ExpectedError: safecast.ErrUnsupportedConversion},
+		"float32 negative": MapArithmeticTest[float32]{Operation: unary(safecast.Abs[float32]), A: -1.5, ExpectedOutput: 1.5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+type MapArithmeticAsTest[TypeOutput safecast.Number] struct {
+	Operation      func() (TypeOutput, error)
+	ExpectedOutput TypeOutput
+	ExpectedError  error
+	ErrorContains  string
+}
+
+func (mt MapArithmeticAsTest[O]) Run(t *testing.T) {
+	t.Helper()
+
+	out, err := mt.Operation()
+	if mt.ExpectedError != nil {
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, mt.ExpectedError)
+
+		if mt.ErrorContains != "" {
+			requireErrorContains(t, err, mt.ErrorContains)
+		}
+
+		assertEqual(t, 0, out)
+		return
+	}
+
+	assertNoError(t, err)
+	assertEqual(t, mt.ExpectedOutput, out)
+}
+
+func TestAddAs(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int64 + uint32 into int": MapArithmeticAsTest[int]{
+			Operation:      func() (int, error) { return safecast.AddAs[int](int64(40), uint32(2)) },
+			ExpectedOutput: 42,
+		},
+		"negative int8 + uint64 into uint8": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.AddAs[uint8](int8(-100), uint64(355)) },
+			ExpectedOutput: 255,
+		},
+		"intermediate values out of Out range": MapArithmeticAsTest[int8]{
+			Operation:      func() (int8, error) { return safecast.AddAs[int8](uint64(math.MaxUint64), int64(math.MinInt64)) },
+			ExpectedOutput: 0,
+			ExpectedError:  safecast.ErrExceedMaximumValue,
+		},
+		"max uint64 + min int64 fits int64": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.AddAs[int64](uint64(math.MaxUint64), int64(math.MinInt64)) },
+			ExpectedOutput: math.MaxInt64,
+		},
+		"sum of maximums into uint64": MapArithmeticAsTest[uint64]{
+			Operation:     func() (uint64, error) { return safecast.AddAs[uint64](uint64(math.MaxUint64), uint8(1)) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "18446744073709551615 (uint64) + 1 (uint8) is greater than 18446744073709551615 (uint64)",
+		},
+		"sum of minimums into int64": MapArithmeticAsTest[int64]{
+			Operation:     func() (int64, error) { return safecast.AddAs[int64](int64(math.MinInt64), int64(math.MinInt64)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"min int64 exactly": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.AddAs[int64](int64(math.MinInt64+1), int8(-1)) },
+			ExpectedOutput: math.MinInt64,
+		},
+		"just below min int64": MapArithmeticAsTest[int64]{
+			Operation:     func() (int64, error) { return safecast.AddAs[int64](int64(math.MinInt64), int8(-1)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"negative into unsigned": MapArithmeticAsTest[uint32]{
+			Operation:     func() (uint32, error) { return safecast.AddAs[uint32](int64(-43), uint8(42)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+			ErrorContains: "-43 (int64) + 42 (uint8) is less than 0 (uint32)",
+		},
+		"opposite values": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.AddAs[uint8](int64(-42), uint16(42)) },
+			ExpectedOutput: 0,
+		},
+		"float operand": MapArithmeticAsTest[int]{
+			Operation:      func() (int, error) { return safecast.AddAs[int](41.5, uint8(1)) },
+			ExpectedOutput: 42,
+		},
+		"float output": MapArithmeticAsTest[float32]{
+			Operation:      func() (float32, error) { return safecast.AddAs[float32](int64(40), uint8(2)) },
+			ExpectedOutput: 42,
+		},
+		"large integer and float": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.AddAs[int64](int64(1<<53+1), float32(0)) },
+			ExpectedOutput: 1<<53 + 1,
+		},
+		"max int64 and float": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.AddAs[int64](int64(math.MaxInt64), 0.0) },
+			ExpectedOutput: math.MaxInt64,
+		},
+		"max int64 and float fraction": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.AddAs[int64](int64(math.MaxInt64), 0.5) },
+			ExpectedOutput: math.MaxInt64,
+		},
+		"max int64 and float overflow": MapArithmeticAsTest[int64]{
+			Operation:     func() (int64, error) { return safecast.AddAs[int64](int64(math.MaxInt64), 1.0) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "9223372036854775807 (int64) + 1 (float64) is greater than 9223372036854775807 (int64)",
+		},
+		"integers beyond 64 bits into float": MapArithmeticAsTest[float64]{
+			Operation: func() (float64, error) {
+				return safecast.AddAs[float64](uint64(math.MaxUint64), uint64(math.MaxUint64))
+			},
+			ExpectedOutput: 2 * math.MaxUint64,
+		},
+		"float infinity": MapArithmeticAsTest[float64]{
+			Operation:     func() (float64, error) { return safecast.AddAs[float64](int64(1), math.Inf(-1)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"float overflow": MapArithmeticAsTest[float32]{
+			Operation:     func() (float32, error) { return safecast.AddAs[float32](math.MaxFloat64, int8(1)) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"float NaN": MapArithmeticAsTest[int]{
+			Operation:     func() (int, error) { return safecast.AddAs[int](math.NaN(), int8(1)) },
+			ExpectedError: safecast.ErrUnsupportedConversion,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestSubAs(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int64 - uint16 into uint8": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.SubAs[uint8](int64(300), uint16(100)) },
+			ExpectedOutput: 200,
+		},
+		"uint8 - uint8 into int8": MapArithmeticAsTest[int8]{
+			Operation:      func() (int8, error) { return safecast.SubAs[int8](uint8(0), uint8(128)) },
+			ExpectedOutput: math.MinInt8,
+		},
+		"negative result into unsigned": MapArithmeticAsTest[uint]{
+			Operation:     func() (uint, error) { return safecast.SubAs[uint](uint8(1), uint8(2)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+			ErrorContains: "1 (uint8) - 2 (uint8) is less than 0 (uint)",
+		},
+		"subtracting min int64": MapArithmeticAsTest[uint64]{
+			Operation:      func() (uint64, error) { return safecast.SubAs[uint64](int64(math.MaxInt64), int64(math.MinInt64)) },
+			ExpectedOutput: math.MaxUint64,
+		},
+		"beyond 64 bits": MapArithmeticAsTest[uint64]{
+			Operation:     func() (uint64, error) { return safecast.SubAs[uint64](uint64(math.MaxUint64), int64(math.MinInt64)) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"below 64 bits": MapArithmeticAsTest[int64]{
+			Operation:     func() (int64, error) { return safecast.SubAs[int64](int64(math.MinInt64), uint64(math.MaxUint64)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"zero": MapArithmeticAsTest[int8]{
+			Operation:      func() (int8, error) { return safecast.SubAs[int8](uint64(math.MaxUint64), uint64(math.MaxUint64)) },
+			ExpectedOutput: 0,
+		},
+		"float truncated to zero": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.SubAs[uint8](float32(1), 1.5) },
+			ExpectedOutput: 0,
+		},
+		"large integer and float": MapArithmeticAsTest[int64]{
+			Operation:      func() (int64, error) { return safecast.SubAs[int64](int64(math.MinInt64), -1.0) },
+			ExpectedOutput: math.MinInt64 + 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestMulAs(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"int64 * uint8 into int32": MapArithmeticAsTest[int32]{
+			Operation:      func() (int32, error) { return safecast.MulAs[int32](int64(1<<20), uint8(2)) },
+			ExpectedOutput: 1 << 21,
+		},
+		"negative results": MapArithmeticAsTest[int8]{
+			Operation:      func() (int8, error) { return safecast.MulAs[int8](int64(-64), uint64(2)) },
+			ExpectedOutput: math.MinInt8,
+		},
+		"two negative values": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.MulAs[uint8](int8(-15), int16(-17)) },
+			ExpectedOutput: math.MaxUint8,
+		},
+		"by zero": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.MulAs[uint8](int64(math.MinInt64), uint8(0)) },
+			ExpectedOutput: 0,
+		},
+		"128 bits product": MapArithmeticAsTest[uint64]{
+			Operation:     func() (uint64, error) { return safecast.MulAs[uint64](uint64(math.MaxUint64), uint64(math.MaxUint64)) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "18446744073709551615 (uint64) * 18446744073709551615 (uint64) is greater than",
+		},
+		"128 bits negative product": MapArithmeticAsTest[int64]{
+			Operation:     func() (int64, error) { return safecast.MulAs[int64](int64(math.MinInt64), uint64(math.MaxUint64)) },
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"min int64 times minus one": MapArithmeticAsTest[uint64]{
+			Operation:      func() (uint64, error) { return safecast.MulAs[uint64](int64(math.MinInt64), int8(-1)) },
+			ExpectedOutput: 1 << 63,
+		},
+		"overflows int16": MapArithmeticAsTest[int16]{
+			Operation:     func() (int16, error) { return safecast.MulAs[int16](uint8(255), uint8(255)) },
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"float": MapArithmeticAsTest[uint8]{
+			Operation:      func() (uint8, error) { return safecast.MulAs[uint8](0.5, uint16(300)) },
+			ExpectedOutput: 150,
+		},
+		"large integer and float": MapArithmeticAsTest[uint64]{
+			Operation:      func() (uint64, error) { return safecast.MulAs[uint64](uint64(1<<63+1), 1.0) },
+			ExpectedOutput: 1<<63 + 1,
+		},
+		"large integer and float fraction": MapArithmeticAsTest[uint64]{
+			Operation:      func() (uint64, error) { return safecast.MulAs[uint64](uint64(math.MaxUint64), 0.5) },
+			ExpectedOutput: math.MaxUint64 / 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestMustArithmetic(t *testing.T) {
+	// [TestAdd] and the others tested all the cases
+	// here we are simply checking that the functions panic on errors
+
+	for name, tt := range map[string]struct {
+		fn            func() int8
+		expected      int8
+		expectedError error
+	}{
+		"add":            {fn: func() int8 { return safecast.MustAdd[int8](40, 2) }, expected: 42},
+		"sub":            {fn: func() int8 { return safecast.MustSub[int8](44, 2) }, expected: 42},
+		"mul":            {fn: func() int8 { return safecast.MustMul[int8](21, 2) }, expected: 42},
+		"div":            {fn: func() int8 { return safecast.MustDiv[int8](84, 2) }, expected: 42},
+		"mod":            {fn: func() int8 { return safecast.MustMod[int8](85, 43) }, expected: 42},
+		"neg":            {fn: func() int8 { return safecast.MustNeg[int8](-42) }, expected: 42},
+		"abs":            {fn: func() int8 { return safecast.MustAbs[int8](-42) }, expected: 42},
+		"add overflow":   {fn: func() int8 { return safecast.MustAdd[int8](127, 1) }, expectedError: safecast.ErrExceedMaximumValue},
+		"sub overflow":   {fn: func() int8 { return safecast.MustSub[int8](-128, 1) }, expectedError: safecast.ErrExceedMinimumValue},
+		"mul overflow":   {fn: func() int8 { return safecast.MustMul[int8](64, 2) }, expectedError: safecast.ErrExceedMaximumValue},
+		"div by zero":    {fn: func() int8 { return safecast.MustDiv[int8](42, 0) }, expectedError: safecast.ErrDivisionByZero},
+		"mod by zero":    {fn: func() int8 { return safecast.MustMod[int8](42, 0) }, expectedError: safecast.ErrDivisionByZero},
+		"neg overflow":   {fn: func() int8 { return safecast.MustNeg[int8](-128) }, expectedError: safecast.ErrExceedMaximumValue},
+		"abs overflow":   {fn: func() int8 { return safecast.MustAbs[int8](-128) }, expectedError: safecast.ErrExceedMaximumValue},
+		"float overflow": {fn: func() int8 { return int8(safecast.MustAdd(math.MaxFloat64, math.MaxFloat64)) }, expectedError: safecast.ErrExceedMaximumValue},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if tt.expectedError == nil {
+					if r != nil {
+						t.Fatalf("unexpected panic: %v", r)
+					}
+					return
+				}
+
+				err, ok := r.(error)
+				if !ok {
+					t.Fatalf("panic value is not an error: %v", r)
+				}
+				requireErrorIs(t, err, safecast.ErrConversionIssue)
+				requireErrorIs(t, err, tt.expectedError)
+			}()
+
+			assertEqual(t, tt.expected, tt.fn())
+		})
+	}
+}
+
+func TestRequireArithmetic(t *testing.T) {
+	// [TestAdd] and the others tested all the cases
+	// here we are simply checking that the test fails on errors
+
+	for name, tt := range map[string]struct {
+		fn                  func(t safecast.TestingT) uint8
+		expected            uint8
+		expectedTestFailure bool
+	}{
+		"add":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireAdd[uint8](t, 40, 2) }, expected: 42},
+		"sub":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireSub[uint8](t, 44, 2) }, expected: 42},
+		"mul":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireMul[uint8](t, 21, 2) }, expected: 42},
+		"div":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireDiv[uint8](t, 84, 2) }, expected: 42},
+		"mod":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireMod[uint8](t, 85, 43) }, expected: 42},
+		"neg":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireNeg[uint8](t, 0) }, expected: 0},
+		"abs":          {fn: func(t safecast.TestingT) uint8 { return safecast.RequireAbs[uint8](t, 42) }, expected: 42},
+		"add overflow": {fn: func(t safecast.TestingT) uint8 { return safecast.RequireAdd[uint8](t, 255, 1) }, expectedTestFailure: true},
+		"sub overflow": {fn: func(t safecast.TestingT) uint8 { return safecast.RequireSub[uint8](t, 0, 1) }, expected: 255, expectedTestFailure: true},
+		"mul overflow": {fn: func(t safecast.TestingT) uint8 { return safecast.RequireMul[uint8](t, 16, 16) }, expectedTestFailure: true},
+		"div by zero":  {fn: func(t safecast.TestingT) uint8 { return safecast.RequireDiv[uint8](t, 42, 0) }, expectedTestFailure: true},
+		"mod by zero":  {fn: func(t safecast.TestingT) uint8 { return safecast.RequireMod[uint8](t, 42, 0) }, expectedTestFailure: true},
+		"neg overflow": {fn: func(t safecast.TestingT) uint8 { return safecast.RequireNeg[uint8](t, 1) }, expected: 255, expectedTestFailure: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := new(mockTestingT)
+			assertEqual(t, tt.expected, tt.fn(m))
+			assertEqual(t, tt.expectedTestFailure, m.Failed())
+		})
+	}
+}
+
+func ExampleAdd() {
+	sum, err := safecast.Add[uint8](200, 55)
+	fmt.Println(sum, err)
+
+	sum, err = safecast.Add[uint8](200, 56)
+	fmt.Println(sum, err)
+	fmt.Println(errors.Is(err, safecast.ErrRangeOverflow))
+
+	// Output:
+	// 255 <nil>
+	// 0 conversion issue: 200 (uint8) + 56 (uint8) is greater than 255 (uint8): maximum value for this type exceeded
+	// true
+}
+
+func ExampleDiv() {
+	q, err := safecast.Div(84, 2)
+	fmt.Println(q, err)
+
+	_, err = safecast.Div(42, 0)
+	fmt.Println(err)
+
+	_, err = safecast.Div[int8](-128, -1)
+	fmt.Println(err)
+
+	// Output:
+	// 42 <nil>
+	// conversion issue: 42 (int) / 0 (int): division by zero
+	// conversion issue: -128 (int8) / -1 (int8) is greater than 127 (int8): maximum value for this type exceeded
+}
+
+func ExampleAbs() {
+	a, err := safecast.Abs[int8](-127)
+	fmt.Println(a, err)
+
+	_, err = safecast.Abs[int8](-128)
+	fmt.Println(err)
+
+	// Output:
+	// 127 <nil>
+	// conversion issue: absolute value of -128 (int8) is greater than 127 (int8): maximum value for this type exceeded
+}
+
+func ExampleAddAs() {
+	var offset int64 = 1 << 40
+	var length uint32 = 42
+
+	end, err := safecast.AddAs[int64](offset, length)
+	fmt.Println(end, err)
+
+	_, err = safecast.AddAs[int32](offset, length)
+	fmt.Println(err)
+
+	// Output:
+	// 1099511627818 <nil>
+	// conversion issue: 1099511627776 (int64) + 42 (uint32) is greater than 2147483647 (int32): maximum value for this type exceeded
+}
@@ -0,0 +1,233 @@
+package safecast
+
+import (
+	"math"
+	"math/big"
+)
+
+// ConvertFromBigInt attempts to convert a [*big.Int] to the desired [Number] type.
+//
+// # Behavior
+//
+//   - If the conversion is possible, the converted value is returned.
+//   - If the conversion fails, zero is returned with the error.
+//
+// # Errors when conversion exceeds range of the desired type, the following errors are wrapped in the returned error:
+//
+//   - [ErrRangeOverflow] when the value is outside the range of the desired type. (example: 1000 or -1 to uint8).
+//   - [ErrExceedMaximumValue] when the value exceeds the maximum value of the desired type (example: 1000 to uint8).
+//   - [ErrExceedMinimumValue] when the value is less than the minimum value of the desired type (example: -1 to uint16).
+//
+// # Errors when conversion is not possible, the following errors are wrapped in the returned error:
+//
+//   - [ErrUnsupportedConversion] when the value is nil.
+//
+// # General errors wrapped on conversion failure:
+//
+//   - [ErrConversionIssue] is always wrapped in the returned error when [ConvertFromBigInt] fails.
+//
+// # Options
+//
+// The [ConvertOption]s are the same as the ones of [Convert], such as [WithSaturation].
+// [WithPrecisionLossReport] reports the values that cannot be represented exactly when converting to a floating-point type.
+func ConvertFromBigInt[NumOut Number](orig *big.Int, opts ...ConvertOption) (NumOut, error) {
+	config := newConvertOptions(opts...)
+
+	converted, err := convertFromBigInt[NumOut](orig, config)
+	return applyErrorOptions(converted, err, config)
+}
+
+// ToBigInt converts any [Number] to a [*big.Int].
+//
+// Floating-point values are truncated toward zero, as Go does when converting them to an integer.
+// As [math.NaN] and [math.Inf] cannot be represented as an integer, nil is returned for them.
+func ToBigInt[NumIn Number](orig NumIn) *big.Int {
+	switch {
+	case isFloat[NumIn]():
+		f := float64(orig)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil
+		}
+		i, _ := big.NewFloat(f).Int(nil)
+		return i
+	case isNegative(orig):
+		return big.NewInt(int64(orig))
+	}
+	return new(big.Int).SetUint64(uint64(orig))
+}
+
+// ConvertFromBigFloat attempts to convert a [*big.Float] to the desired [Number] type.
+//
+// The conversion follows the same rules as [ConvertFromBigRat], the value being converted exactly to a [*big.Rat] first.
+// Infinite values are reported as out of range, as [Convert] does for [math.Inf].
+func ConvertFromBigFloat[NumOut Number](orig *big.Float, opts ...ConvertOption) (NumOut, error) {
+	config := newConvertOptions(opts...)
+
+	converted, err := convertFromBigFloat[NumOut](orig, config)
+	return applyErrorOptions(converted, err, config)
+}
+
+// ConvertFromBigRat attempts to convert a [*big.Rat] to the desired [Number] type.
+//
+// # Behavior
+//
+//   - If the conversion is possible, the converted value is returned.
+//   - If the conversion fails, zero is returned with the error.
+//   - When converting to an integer type, the value is truncated toward zero, unless [WithRounding] is used.
+//   - When converting to a floating-point type, the value is rounded to the nearest representable value.
+//
+// # Errors when conversion exceeds range of the desired type, the following errors are wrapped in the returned error:
+//
+//   - [ErrRangeOverflow] when the value is outside the range of the desired type. (example: 1000 or -1 to uint8).
+//   - [ErrExceedMaximumValue] when the value exceeds the maximum value of the desired type (example: 1000 to uint8).
+//   - [ErrExceedMinimumValue] when the value is less than the minimum value of the desired type (example: -1 to uint16).
+//
+// # Errors when conversion is not possible, the following errors are wrapped in the returned error:
+//
+//   - [ErrUnsupportedConversion] when the value is nil.
+//   - [ErrDecimalLoss] when the value is not an integer and [WithDecimalLossReport] is used (example: 1/3 to int).
+//   - [ErrPrecisionLoss] when the value cannot be represented exactly as a floating-point value and
+//     [WithPrecisionLossReport] is used (example: 1/3 to float64).
+//
+// # General errors wrapped on conversion failure:
+//
+//   - [ErrConversionIssue] is always wrapped in the returned error when [ConvertFromBigRat] fails.
+func ConvertFromBigRat[NumOut Number](orig *big.Rat, opts ...ConvertOption) (NumOut, error) {
+	config := newConvertOptions(opts...)
+
+	converted, err := convertFromBigRat[NumOut](orig, config)
+	return applyErrorOptions(converted, err, config)
+}
+
+func convertFromBigInt[NumOut Number](orig *big.Int, config *convertConfig) (NumOut, error) {
+	if orig == nil {
+		return 0, newConversionError[NumOut](orig, ErrUnsupportedConversion)
+	}
+
+	if isFloat[NumOut]() {
+		// the precision of the big.Float is set to hold the value exactly
+		return bigToFloat[NumOut](orig, new(big.Float).SetInt(orig), config)
+	}
+
+	return bigToInteger[NumOut](orig, orig, config)
+}
+
+func convertFromBigFloat[NumOut Number](orig *big.Float, config *convertConfig) (NumOut, error) {
+	if orig == nil {
+		return 0, newConversionError[NumOut](orig, ErrUnsupportedConversion)
+	}
+
+	if orig.IsInf() {
+		return 0, getBigRangeError[NumOut](orig, orig.Sign())
+	}
+
+	if isFloat[NumOut]() {
+		return bigToFloat[NumOut](orig, orig, config)
+	}
+
+	// a finite big.Float is always an exact rational number
+	r, _ := orig.Rat(nil)
+	return bigRatToInteger[NumOut](orig, r, config)
+}
+
+func convertFromBigRat[NumOut Number](orig *big.Rat, config *convertConfig) (NumOut, error) {
+	if orig == nil {
+		return 0, newConversionError[NumOut](orig, ErrUnsupportedConversion)
+	}
+
+	if isFloat[NumOut]() {
+		var (
+			f     float64
+			exact bool
+		)
+		if isFloat32[NumOut]() {
+			var f32 float32
+			f32, exact = orig.Float32()
+			f = float64(f32)
+		} else {
+			f, exact = orig.Float64()
+		}
+		return checkBigFloatResult[NumOut](orig, f, exact, orig.Sign(), config)
+	}
+
+	return bigRatToInteger[NumOut](orig, orig, config)
+}
+
+// bigToFloat converts f to the desired floating-point type.
+//
+// value is the original value, used in error messages.
+func bigToFloat[NumOut Number](value any, f *big.Float, config *convertConfig) (NumOut, error) {
+	var (
+		converted float64
+		accuracy  big.Accuracy
+	)
+	if isFloat32[NumOut]() {
+		var f32 float32
+		f32, accuracy = f.Float32()
+		converted = float64(f32)
+	} else {
+		converted, accuracy = f.Float64()
+	}
+	return checkBigFloatResult[NumOut](value, converted, accuracy == big.Exact, f.Sign(), config)
+}
+
+// checkBigFloatResult reports the errors of a conversion from a big number to a floating-point type.
+func checkBigFloatResult[NumOut Number](value any, f float64, exact bool, sign int, config *convertConfig) (NumOut, error) {
+	if math.IsInf(f, 0) {
+		return 0, getBigRangeError[NumOut](value, sign)
+	}
+
+	if config.reportPrecisionLoss && !exact {
+		return 0, newConversionError[NumOut](value, ErrPrecisionLoss)
+	}
+
+	return NumOut(f), nil
+}
+
+// bigRatToInteger rounds r according to the rounding mode, and converts it to the desired integer type.
+//
+// value is the original value, used in error messages.
+func bigRatToInteger[NumOut Number](value any, r *big.Rat, config *convertConfig) (NumOut, error) {
+	rounded, exact := config.rounding.roundRat(r)
+
+	// the range is checked on the rounded value, as Convert does
+	converted, err := bigToInteger[NumOut](value, rounded, config)
+	if err != nil {
+		return 0, err
+	}
+
+	if config.reportDecimalLoss && !exact {
+		return 0, newConversionError[NumOut](value, ErrDecimalLoss)
+	}
+
+	return converted, nil
+}
+
+// bigToInteger converts i to the desired integer type.
+//
+// value is the original value, used in error messages.
+func bigToInteger[NumOut Number](value any, i *big.Int, config *convertConfig) (NumOut, error) {
+	// the values fitting in int64 or uint64 are checked by Convert, so the range checks are the same
+	switch {
+	case i.IsInt64():
+		if converted, err := convertNumber[NumOut](i.Int64(), config); err == nil {
+			return converted, nil
+		}
+	case i.IsUint64():
+		if converted, err := convertNumber[NumOut](i.Uint64(), config); err == nil {
+			return converted, nil
+		}
+	}
+
+	return 0, getBigRangeError[NumOut](value, i.Sign())
+}
+
+// getBigRangeError is the counterpart of [getRangeError] for the types of [math/big].
+func getBigRangeError[NumOut Number](value any, sign int) error {
+	err := ErrExceedMaximumValue
+	if sign < 0 {
+		err = ErrExceedMinimumValue
+	}
+
+	return newConversionError[NumOut](value, err)
+}
@@ -0,0 +1,333 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func bigInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big.Int %q", s)
+	}
+	return i
+}
+
+type MapBigIntTest[TypeOutput safecast.Number] struct {
+	Input          string
+	Options        []safecast.ConvertOption
+	ExpectedOutput TypeOutput
+	ExpectedError  error
+	ErrorContains  string
+}
+
+func (mt MapBigIntTest[O]) Run(t *testing.T) {
+	t.Helper()
+
+	out, err := safecast.ConvertFromBigInt[O](bigInt(t, mt.Input), mt.Options...)
+	if mt.ExpectedError != nil {
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, mt.ExpectedError)
+
+		if mt.ErrorContains != "" {
+			requireErrorContains(t, err, mt.ErrorContains)
+		}
+
+		return
+	}
+
+	assertNoError(t, err)
+	assertEqual(t, mt.ExpectedOutput, out)
+}
+
+func TestConvertFromBigInt(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"zero to int":            MapBigIntTest[int]{Input: "0", ExpectedOutput: 0},
+		"int8":                   MapBigIntTest[int8]{Input: "-128", ExpectedOutput: math.MinInt8},
+		"uint8":                  MapBigIntTest[uint8]{Input: "255", ExpectedOutput: math.MaxUint8},
+		"int32":                  MapBigIntTest[int32]{Input: "-2147483648", ExpectedOutput: math.MinInt32},
+		"uint32":                 MapBigIntTest[uint32]{Input: "4294967295", ExpectedOutput: math.MaxUint32},
+		"int64":                  MapBigIntTest[int64]{Input: "-9223372036854775808", ExpectedOutput: math.MinInt64},
+		"uint64":                 MapBigIntTest[uint64]{Input: "18446744073709551615", ExpectedOutput: math.MaxUint64},
+		"uintptr":                MapBigIntTest[uintptr]{Input: "42", ExpectedOutput: 42},
+		"float32":                MapBigIntTest[float32]{Input: "-16777216", ExpectedOutput: -16777216},
+		"float64":                MapBigIntTest[float64]{Input: "1" + zeros(300), ExpectedOutput: 1e300},
+		"int8 overflows":         MapBigIntTest[int8]{Input: "128", ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "128 (*big.Int) is greater than 127 (int8)"},
+		"int8 underflows":        MapBigIntTest[int8]{Input: "-129", ExpectedError: safecast.ErrExceedMinimumValue, ErrorContains: "-129 (*big.Int) is less than -128 (int8)"},
+		"uint8 negative":         MapBigIntTest[uint8]{Input: "-1", ExpectedError: safecast.ErrExceedMinimumValue},
+		"uint32 overflows":       MapBigIntTest[uint32]{Input: "4294967296", ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 overflows":        MapBigIntTest[int64]{Input: "9223372036854775808", ExpectedError: safecast.ErrExceedMaximumValue},
+		"int64 underflows":       MapBigIntTest[int64]{Input: "-9223372036854775809", ExpectedError: safecast.ErrExceedMinimumValue},
+		"uint64 overflows":       MapBigIntTest[uint64]{Input: "18446744073709551616", ExpectedError: safecast.ErrExceedMaximumValue},
+		"uint64 negative huge":   MapBigIntTest[uint64]{Input: "-18446744073709551616", ExpectedError: safecast.ErrExceedMinimumValue},
+		"float32 overflows":      MapBigIntTest[float32]{Input: "1" + zeros(39), ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "is greater than 3.4028235e+38 (float32)"},
+		"float64 underflows":     MapBigIntTest[float64]{Input: "-1" + zeros(309), ExpectedError: safecast.ErrExceedMinimumValue},
+		"int8 saturated":         MapBigIntTest[int8]{Input: "-1000", Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: math.MinInt8},
+		"uint64 saturated":       MapBigIntTest[uint64]{Input: "1" + zeros(30), Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: math.MaxUint64},
+		"float32 saturated":      MapBigIntTest[float32]{Input: "1" + zeros(39), Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: math.MaxFloat32},
+		"saturation not applied": MapBigIntTest[int16]{Input: "1000", Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: 1000},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		out, err := safecast.ConvertFromBigInt[int](nil)
+		assertEqual(t, 0, out)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		requireErrorContains(t, err, "<nil> (*big.Int) is not supported")
+	})
+}
+
+func zeros(n int) string {
+	return strings.Repeat("0", n)
+}
+
+func TestToBigInt(t *testing.T) {
+	for name, tt := range map[string]struct {
+		got      *big.Int
+		expected string
+	}{
+		"int":            {got: safecast.ToBigInt(42), expected: "42"},
+		"int8":           {got: safecast.ToBigInt(int8(math.MinInt8)), expected: "-128"},
+		"int64 min":      {got: safecast.ToBigInt(int64(math.MinInt64)), expected: "-9223372036854775808"},
+		"int64 max":      {got: safecast.ToBigInt(int64(math.MaxInt64)), expected: "9223372036854775807"},
+		"uint64 max":     {got: safecast.ToBigInt(uint64(math.MaxUint64)), expected: "18446744073709551615"},
+		"uintptr":        {got: safecast.ToBigInt(uintptr(42)), expected: "42"},
+		"float64":        {got: safecast.ToBigInt(-2.9), expected: "-2"},
+		"float32":        {got: safecast.ToBigInt(float32(2.9)), expected: "2"},
+		"float64 huge":   {got: safecast.ToBigInt(1e30), expected: "1000000000000000019884624838656"},
+		"float64 tiny":   {got: safecast.ToBigInt(-0.5), expected: "0"},
+		"float64 max":    {got: safecast.ToBigInt(math.MaxFloat64), expected: new(big.Int).Lsh(big.NewInt(1<<53-1), 971).String()},
+		"float32 max":    {got: safecast.ToBigInt(float32(math.MaxFloat32)), expected: new(big.Int).Lsh(big.NewInt(1<<24-1), 104).String()},
+		"type alias":     {got: safecast.ToBigInt(myInt(-42)), expected: "-42"},
+		"roundtrip uint": {got: safecast.ToBigInt(safecast.MustConvert[uint32](uint64(math.MaxUint32))), expected: "4294967295"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assertEqual(t, tt.expected, tt.got.String())
+		})
+	}
+
+	t.Run("not a number", func(t *testing.T) {
+		for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			if got := safecast.ToBigInt(f); got != nil {
+				t.Errorf("expected nil for %v, got %v", f, got)
+			}
+		}
+	})
+}
+
+type myInt int
+
+func ExampleConvertFromBigInt() {
+	amount, _ := new(big.Int).SetString("12345678901234567890", 10)
+
+	u, err := safecast.ConvertFromBigInt[uint64](amount)
+	fmt.Println(u, err)
+
+	_, err = safecast.ConvertFromBigInt[int64](amount)
+	fmt.Println(err)
+
+	// Output:
+	// 12345678901234567890 <nil>
+	// conversion issue: 12345678901234567890 (*big.Int) is greater than 9223372036854775807 (int64): maximum value for this type exceeded
+}
+
+func ExampleToBigInt() {
+	total := safecast.ToBigInt(uint64(math.MaxUint64))
+	total.Add(total, safecast.ToBigInt(int8(1)))
+	fmt.Println(total)
+
+	// Output:
+	// 18446744073709551616
+}
+
+func bigRat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		t.Fatalf("invalid big.Rat %q", s)
+	}
+	return r
+}
+
+type MapBigRatTest[TypeOutput safecast.Number] struct {
+	Input          string
+	Options        []safecast.ConvertOption
+	ExpectedOutput TypeOutput
+	ExpectedError  error
+	ErrorContains  string
+}
+
+func (mt MapBigRatTest[O]) Run(t *testing.T) {
+	t.Helper()
+
+	t.Run("big.Rat", func(t *testing.T) {
+		out, err := safecast.ConvertFromBigRat[O](bigRat(t, mt.Input), mt.Options...)
+		mt.check(t, out, err)
+	})
+
+	// big.Float can only hold exactly the values with a power of two denominator,
+	// so the big.Float is only tested when the value can be represented exactly
+	f, ok := new(big.Float).SetPrec(1024).SetString(mt.Input)
+	if !ok {
+		return
+	}
+	if r, _ := f.Rat(nil); r.Cmp(bigRat(t, mt.Input)) != 0 {
+		return
+	}
+
+	t.Run("big.Float", func(t *testing.T) {
+		out, err := safecast.ConvertFromBigFloat[O](f, mt.Options...)
+		// the value in the message is formatted differently for big.Float
+		mt.ErrorContains = ""
+		mt.check(t, out, err)
+	})
+}
+
+func (mt MapBigRatTest[O]) check(t *testing.T, out O, err error) {
+	t.Helper()
+
+	if mt.ExpectedError != nil {
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, mt.ExpectedError)
+
+		if mt.ErrorContains != "" {
+			requireErrorContains(t, err, mt.ErrorContains)
+		}
+
+		assertEqual(t, O(0), out)
+		return
+	}
+
+	assertNoError(t, err)
+	assertEqual(t, mt.ExpectedOutput, out)
+}
+
+func TestConvertFromBigRat(t *testing.T) {
+	decimalLoss := []safecast.ConvertOption{safecast.WithDecimalLossReport()}
+	precisionLoss := []safecast.ConvertOption{safecast.WithPrecisionLossReport()}
+	rounding := func(mode safecast.RoundingMode) []safecast.ConvertOption {
+		return []safecast.ConvertOption{safecast.WithRounding(mode), safecast.WithDecimalLossReport()}
+	}
+
+	for name, tt := range map[string]TestRunner{
+		"zero":                       MapBigRatTest[int]{Input: "0", ExpectedOutput: 0},
+		"integer":                    MapBigRatTest[int8]{Input: "-128", ExpectedOutput: math.MinInt8},
+		"fraction truncated":         MapBigRatTest[int]{Input: "7/2", ExpectedOutput: 3},
+		"negative fraction":          MapBigRatTest[int]{Input: "-7/2", ExpectedOutput: -3},
+		"small negative to unsigned": MapBigRatTest[uint]{Input: "-1/3", ExpectedOutput: 0},
+		"decimal":                    MapBigRatTest[uint8]{Input: "255.75", ExpectedOutput: 255},
+		"uint64 max":                 MapBigRatTest[uint64]{Input: "18446744073709551615.5", ExpectedOutput: math.MaxUint64},
+		"decimal loss":               MapBigRatTest[int]{Input: "1/3", Options: decimalLoss, ExpectedError: safecast.ErrDecimalLoss},
+		"no decimal loss":            MapBigRatTest[int]{Input: "10/5", Options: decimalLoss, ExpectedOutput: 2},
+		"overflows":                  MapBigRatTest[uint8]{Input: "256", ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "256/1 (*big.Rat) is greater than 255 (uint8)"},
+		"underflows":                 MapBigRatTest[int8]{Input: "-129.5", ExpectedError: safecast.ErrExceedMinimumValue},
+		"negative to unsigned":       MapBigRatTest[uint64]{Input: "-1", ExpectedError: safecast.ErrExceedMinimumValue},
+		"huge":                       MapBigRatTest[int64]{Input: "1e30", ExpectedError: safecast.ErrExceedMaximumValue},
+		"range checked first":        MapBigRatTest[uint8]{Input: "300.5", Options: decimalLoss, ExpectedError: safecast.ErrExceedMaximumValue},
+		"saturated":                  MapBigRatTest[uint8]{Input: "-1e30", Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: 0},
+
+		"floor":                   MapBigRatTest[int]{Input: "-5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundFloor)}, ExpectedOutput: -3},
+		"ceil":                    MapBigRatTest[int]{Input: "5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundCeil)}, ExpectedOutput: 3},
+		"half away from zero":     MapBigRatTest[int]{Input: "-5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfAwayFromZero)}, ExpectedOutput: -3},
+		"half away below half":    MapBigRatTest[int]{Input: "-12/5", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfAwayFromZero)}, ExpectedOutput: -2},
+		"half to even down":       MapBigRatTest[int]{Input: "5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfToEven)}, ExpectedOutput: 2},
+		"half to even up":         MapBigRatTest[int]{Input: "-7/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfToEven)}, ExpectedOutput: -4},
+		"half up":                 MapBigRatTest[int]{Input: "-5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfUp)}, ExpectedOutput: -2},
+		"half up above half":      MapBigRatTest[int]{Input: "-13/5", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfUp)}, ExpectedOutput: -3},
+		"rounded overflow":        MapBigRatTest[uint8]{Input: "255.5", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfUp)}, ExpectedError: safecast.ErrExceedMaximumValue},
+		"rounded with loss":       MapBigRatTest[int]{Input: "5/2", Options: rounding(safecast.RoundCeil), ExpectedError: safecast.ErrDecimalLoss},
+		"rounded without loss":    MapBigRatTest[int]{Input: "-4", Options: rounding(safecast.RoundFloor), ExpectedOutput: -4},
+		"unknown rounding mode":   MapBigRatTest[int]{Input: "-5/2", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundingMode(42))}, ExpectedOutput: -2},
+		"huge rounded to uint64":  MapBigRatTest[uint64]{Input: "18446744073709551614.5", Options: []safecast.ConvertOption{safecast.WithRounding(safecast.RoundCeil)}, ExpectedOutput: math.MaxUint64},
+		"float64":                 MapBigRatTest[float64]{Input: "1/4", Options: precisionLoss, ExpectedOutput: 0.25},
+		"float64 rounded":         MapBigRatTest[float64]{Input: "1/3", ExpectedOutput: 1.0 / 3},
+		"float64 precision loss":  MapBigRatTest[float64]{Input: "1/3", Options: precisionLoss, ExpectedError: safecast.ErrPrecisionLoss, ErrorContains: "precision loss during conversion"},
+		"float64 large integer":   MapBigRatTest[float64]{Input: "9007199254740993", Options: precisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"float64 overflows":       MapBigRatTest[float64]{Input: "1e309", ExpectedError: safecast.ErrExceedMaximumValue},
+		"float32":                 MapBigRatTest[float32]{Input: "-3/8", Options: precisionLoss, ExpectedOutput: -0.375},
+		"float32 rounded":         MapBigRatTest[float32]{Input: "0.1", ExpectedOutput: 0.1},
+		"float32 precision loss":  MapBigRatTest[float32]{Input: "16777217", Options: precisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"float32 overflows":       MapBigRatTest[float32]{Input: "-1e39", ExpectedError: safecast.ErrExceedMinimumValue},
+		"float32 saturated":       MapBigRatTest[float32]{Input: "1e39", Options: []safecast.ConvertOption{safecast.WithSaturation()}, ExpectedOutput: math.MaxFloat32},
+		"precision loss ignored":  MapBigRatTest[int64]{Input: "9007199254740993", Options: precisionLoss, ExpectedOutput: 9007199254740993},
+		"decimal loss for floats": MapBigRatTest[float64]{Input: "1/2", Options: decimalLoss, ExpectedOutput: 0.5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		_, err := safecast.ConvertFromBigRat[int](nil)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		requireErrorContains(t, err, "<nil> (*big.Rat) is not supported")
+
+		_, err = safecast.ConvertFromBigFloat[int](nil)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		requireErrorContains(t, err, "<nil> (*big.Float) is not supported")
+	})
+}
+
+func TestConvertFromBigFloat(t *testing.T) {
+	t.Run("Inf", func(t *testing.T) {
+		_, err := safecast.ConvertFromBigFloat[float64](new(big.Float).SetInf(false))
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "+Inf (*big.Float) is greater than")
+
+		_, err = safecast.ConvertFromBigFloat[int](new(big.Float).SetInf(true))
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+	})
+
+	t.Run("precision loss", func(t *testing.T) {
+		f := new(big.Float).SetPrec(100).SetFloat64(1)
+		f.Add(f, new(big.Float).SetMantExp(big.NewFloat(1), -80))
+
+		out, err := safecast.ConvertFromBigFloat[float64](f)
+		assertNoError(t, err)
+		assertEqual(t, 1.0, out)
+
+		_, err = safecast.ConvertFromBigFloat[float64](f, safecast.WithPrecisionLossReport())
+		requireErrorIs(t, err, safecast.ErrPrecisionLoss)
+
+		_, err = safecast.ConvertFromBigFloat[int](f, safecast.WithDecimalLossReport())
+		requireErrorIs(t, err, safecast.ErrDecimalLoss)
+	})
+}
+
+func TestConvertFromBigInt_precisionLoss(t *testing.T) {
+	_, err := safecast.ConvertFromBigInt[float64](bigInt(t, "9007199254740993"), safecast.WithPrecisionLossReport())
+	requireErrorIs(t, err, safecast.ErrPrecisionLoss)
+
+	out, err := safecast.ConvertFromBigInt[float32](bigInt(t, "16777216"), safecast.WithPrecisionLossReport())
+	assertNoError(t, err)
+	assertEqual(t, float32(16777216), out)
+}
+
+func ExampleConvertFromBigRat() {
+	third := big.NewRat(1, 3)
+
+	i, err := safecast.ConvertFromBigRat[int](third, safecast.WithDecimalLossReport())
+	fmt.Println(i, err)
+
+	f, err := safecast.ConvertFromBigRat[float64](third)
+	fmt.Println(f, err)
+
+	_, err = safecast.ConvertFromBigRat[float64](third, safecast.WithPrecisionLossReport())
+	fmt.Println(err)
+
+	// Output:
+	// 0 conversion issue: decimal loss during conversion
+	// 0.3333333333333333 <nil>
+	// conversion issue: precision loss during conversion
+}
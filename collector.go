@@ -0,0 +1,150 @@
+package safecast
+
+import (
+	"math/big"
+	"reflect"
+)
+
+// Collector converts many values, and collects all the conversion errors instead of stopping at the first one.
+//
+// It is intended to convert the fields of a decoded payload or a configuration, and report all the invalid fields at once.
+//
+//	c := NewCollector()
+//	c.Int32(&spec.Replicas, payload.Replicas, "spec.replicas")
+//	c.Uint16(&spec.Port, payload.Port, "spec.port")
+//	if err := c.Err(); err != nil {
+//		return err
+//	}
+//
+// The source value can be any [Number], a string that is parsed with [Parse], or a [*big.Int], [*big.Float],
+// or [*big.Rat]. The types whose underlying type is a number are supported too.
+//
+// The destination is only set when the conversion succeeds.
+//
+// The zero value is ready to use. A Collector must not be used concurrently.
+type Collector struct {
+	opts []ConvertOption
+	errs []error
+}
+
+// NewCollector returns a [Collector] converting the values with the provided [ConvertOption]s.
+func NewCollector(opts ...ConvertOption) *Collector {
+	return &Collector{
+		opts: opts,
+	}
+}
+
+// Err returns the errors collected so far joined as [errors.Join] does, or nil if all the conversions succeeded.
+//
+// Each error is a [ConversionError] referring to its field, and the sentinel errors such as [ErrRangeOverflow]
+// or [ErrStringConversion] can be checked with [errors.Is].
+func (c *Collector) Err() error {
+	return joinErrors(c.errs...)
+}
+
+// Int converts src to an int stored in dst, see [Collector].
+func (c *Collector) Int(dst *int, src any, field string) { collect(c, dst, src, field) }
+
+// Int8 converts src to an int8 stored in dst, see [Collector].
+func (c *Collector) Int8(dst *int8, src any, field string) { collect(c, dst, src, field) }
+
+// Int16 converts src to an int16 stored in dst, see [Collector].
+func (c *Collector) Int16(dst *int16, src any, field string) { collect(c, dst, src, field) }
+
+// Int32 converts src to an int32 stored in dst, see [Collector].
+func (c *Collector) Int32(dst *int32, src any, field string) { collect(c, dst, src, field) }
+
+// Int64 converts src to an int64 stored in dst, see [Collector].
+func (c *Collector) Int64(dst *int64, src any, field string) { collect(c, dst, src, field) }
+
+// Uint converts src to an uint stored in dst, see [Collector].
+func (c *Collector) Uint(dst *uint, src any, field string) { collect(c, dst, src, field) }
+
+// Uint8 converts src to an uint8 stored in dst, see [Collector].
+func (c *Collector) Uint8(dst *uint8, src any, field string) { collect(c, dst, src, field) }
+
+// Uint16 converts src to an uint16 stored in dst, see [Collector].
+func (c *Collector) Uint16(dst *uint16, src any, field string) { collect(c, dst, src, field) }
+
+// Uint32 converts src to an uint32 stored in dst, see [Collector].
+func (c *Collector) Uint32(dst *uint32, src any, field string) { collect(c, dst, src, field) }
+
+// Uint64 converts src to an uint64 stored in dst, see [Collector].
+func (c *Collector) Uint64(dst *uint64, src any, field string) { collect(c, dst, src, field) }
+
+// Float32 converts src to a float32 stored in dst, see [Collector].
+func (c *Collector) Float32(dst *float32, src any, field string) { collect(c, dst, src, field) }
+
+// Float64 converts src to a float64 stored in dst, see [Collector].
+func (c *Collector) Float64(dst *float64, src any, field string) { collect(c, dst, src, field) }
+
+func collect[NumOut Number](c *Collector, dst *NumOut, src any, field string) {
+	converted, err := convertAny[NumOut](src, c.opts)
+	if err != nil {
+		c.errs = append(c.errs, WrapField(field, err))
+		return
+	}
+	*dst = converted
+}
+
+// convertAny converts src to the desired type, depending on its dynamic type.
+func convertAny[NumOut Number](src any, opts []ConvertOption) (NumOut, error) {
+	switch v := src.(type) {
+	case int:
+		return Convert[NumOut](v, opts...)
+	case int8:
+		return Convert[NumOut](v, opts...)
+	case int16:
+		return Convert[NumOut](v, opts...)
+	case int32:
+		return Convert[NumOut](v, opts...)
+	case int64:
+		return Convert[NumOut](v, opts...)
+	case uint:
+		return Convert[NumOut](v, opts...)
+	case uint8:
+		return Convert[NumOut](v, opts...)
+	case uint16:
+		return Convert[NumOut](v, opts...)
+	case uint32:
+		return Convert[NumOut](v, opts...)
+	case uint64:
+		return Convert[NumOut](v, opts...)
+	case uintptr:
+		return Convert[NumOut](v, opts...)
+	case float32:
+		return Convert[NumOut](v, opts...)
+	case float64:
+		return Convert[NumOut](v, opts...)
+	case string:
+		return Parse[NumOut](v, WithConvertOptions(opts...))
+	case *big.Int:
+		return ConvertFromBigInt[NumOut](v, opts...)
+	case *big.Float:
+		return ConvertFromBigFloat[NumOut](v, opts...)
+	case *big.Rat:
+		return ConvertFromBigRat[NumOut](v, opts...)
+	}
+
+	// the types defined from a number, such as time.Duration, are converted from their underlying type
+	var (
+		converted NumOut
+		err       error
+	)
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		converted, err = Convert[NumOut](rv.Int(), opts...)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		converted, err = Convert[NumOut](rv.Uint(), opts...)
+	case reflect.Float32, reflect.Float64:
+		converted, err = Convert[NumOut](rv.Float(), opts...)
+	case reflect.String:
+		converted, err = Parse[NumOut](rv.String(), WithConvertOptions(opts...))
+	default:
+		return 0, newConversionError[NumOut](src, ErrUnsupportedConversion)
+	}
+
+	// the errors refer to the value of the caller, such as time.Duration, not to its underlying value
+	return converted, withValue(src, err)
+}
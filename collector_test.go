@@ -0,0 +1,182 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestCollector(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		var (
+			i   int
+			i8  int8
+			i16 int16
+			i32 int32
+			i64 int64
+			u   uint
+			u8  uint8
+			u16 uint16
+			u32 uint32
+			u64 uint64
+			f32 float32
+			f64 float64
+		)
+
+		c := safecast.NewCollector()
+		c.Int(&i, int8(-1), "int")
+		c.Int8(&i8, uint64(127), "int8")
+		c.Int16(&i16, "-42", "int16")
+		c.Int32(&i32, 42.0, "int32")
+		c.Int64(&i64, big.NewInt(math.MinInt64), "int64")
+		c.Uint(&u, uintptr(42), "uint")
+		c.Uint8(&u8, float32(255), "uint8")
+		c.Uint16(&u16, big.NewRat(84, 2), "uint16")
+		c.Uint32(&u32, big.NewFloat(42), "uint32")
+		c.Uint64(&u64, uint32(math.MaxUint32), "uint64")
+		c.Float32(&f32, int16(-42), "float32")
+		c.Float64(&f64, "4.2", "float64")
+		assertNoError(t, c.Err())
+
+		assertEqual(t, -1, i)
+		assertEqual(t, int8(127), i8)
+		assertEqual(t, int16(-42), i16)
+		assertEqual(t, int32(42), i32)
+		assertEqual(t, int64(math.MinInt64), i64)
+		assertEqual(t, uint(42), u)
+		assertEqual(t, uint8(255), u8)
+		assertEqual(t, uint16(42), u16)
+		assertEqual(t, uint32(42), u32)
+		assertEqual(t, uint64(math.MaxUint32), u64)
+		assertEqual(t, float32(-42), f32)
+		assertEqual(t, 4.2, f64)
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var c safecast.Collector
+		var i8 int8
+		c.Int8(&i8, 42, "int8")
+		assertNoError(t, c.Err())
+		assertEqual(t, int8(42), i8)
+	})
+
+	t.Run("all errors are reported", func(t *testing.T) {
+		i8, u16, i32 := int8(1), uint16(2), int32(3)
+
+		c := safecast.NewCollector()
+		c.Int8(&i8, 200, "spec.replicas")
+		c.Uint16(&u16, "abc", "spec.port")
+		c.Int32(&i32, 42, "spec.timeout")
+		c.Int32(&i32, struct{}{}, "spec.other")
+
+		err := c.Err()
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, safecast.ErrRangeOverflow)
+		requireErrorIs(t, err, safecast.ErrStringConversion)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		requireErrorContains(t, err, `conversion issue: field "spec.replicas": 200 (int) is greater than 127 (int8)`)
+		requireErrorContains(t, err, "conversion issue: field \"spec.port\": cannot convert from `abc` to uint16")
+		requireErrorContains(t, err, `conversion issue: field "spec.other": {} (struct {}) is not supported`)
+
+		errs := err.(interface{ Unwrap() []error }).Unwrap()
+		assertEqual(t, 3, len(errs))
+
+		// the destination is not modified on failure
+		assertEqual(t, int8(1), i8)
+		assertEqual(t, uint16(2), u16)
+		assertEqual(t, int32(42), i32)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		var u8 uint8
+		var i int
+
+		c := safecast.NewCollector(safecast.WithSaturation(), safecast.WithDecimalLossReport())
+		c.Uint8(&u8, "1000", "saturated")
+		c.Int(&i, 4.2, "decimal")
+
+		assertEqual(t, uint8(math.MaxUint8), u8)
+		requireErrorIs(t, c.Err(), safecast.ErrDecimalLoss)
+		assertEqual(t, "decimal", requireConversionError(t, c.Err()).Field)
+	})
+
+	t.Run("defined types", func(t *testing.T) {
+		type name string
+
+		var (
+			i64 int64
+			u8  uint8
+			f32 float32
+			i32 int32
+		)
+
+		c := safecast.NewCollector()
+		c.Int64(&i64, time.Second, "duration")
+		c.Uint8(&u8, myInt(42), "int")
+		c.Float32(&f32, myFloat(0.5), "float")
+		c.Int32(&i32, name("42"), "string")
+		assertNoError(t, c.Err())
+
+		assertEqual(t, int64(time.Second), i64)
+		assertEqual(t, uint8(42), u8)
+		assertEqual(t, float32(0.5), f32)
+		assertEqual(t, int32(42), i32)
+
+		c.Uint8(&u8, myInt(-1), "negative")
+		requireErrorIs(t, c.Err(), safecast.ErrExceedMinimumValue)
+	})
+
+	t.Run("defined types are reported", func(t *testing.T) {
+		type name string
+
+		var (
+			i16 int16
+			u8  uint8
+		)
+
+		c := safecast.NewCollector()
+		c.Int16(&i16, time.Second, "timeout")
+		c.Uint8(&u8, name("abc"), "name")
+
+		err := c.Err()
+		requireErrorContains(t, err, `conversion issue: field "timeout": 1s (time.Duration) is greater than 32767 (int16)`)
+		requireErrorContains(t, err, "conversion issue: field \"name\": cannot convert from `abc` to uint8")
+
+		convErr := requireConversionError(t, err)
+		assertEqual(t, any(time.Second), convErr.Value)
+		assertEqual(t, "time.Duration", convErr.From)
+	})
+}
+
+type myFloat float64
+
+func ExampleCollector() {
+	payload := map[string]any{
+		"replicas": int64(3),
+		"port":     "80800",
+		"ratio":    -1.0,
+	}
+
+	var spec struct {
+		Replicas int32
+		Port     uint16
+		Ratio    uint8
+	}
+
+	c := safecast.NewCollector()
+	c.Int32(&spec.Replicas, payload["replicas"], "replicas")
+	c.Uint16(&spec.Port, payload["port"], "port")
+	c.Uint8(&spec.Ratio, payload["ratio"], "ratio")
+
+	fmt.Println(spec.Replicas)
+	fmt.Println(c.Err())
+
+	// Output:
+	// 3
+	// conversion issue: field "port": 80800 (uint64) is greater than 65535 (uint16): maximum value for this type exceeded
+	// conversion issue: field "ratio": -1 (float64) is less than 0 (uint8): minimum value for this type exceeded
+}
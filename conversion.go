@@ -1,6 +1,7 @@
 package safecast
 
 import (
+	"errors"
 	"math"
 )
 
@@ -51,55 +52,153 @@ func RequireConvert[NumOut Number, NumIn Number](t TestingT, orig NumIn) (conver
 //
 //   - [ErrUnsupportedConversion] when the conversion is not possible for the desired type (example: NaN to int).
 //   - [ErrStringConversion] when the conversion from string fails (example: "abc" to int).
+//   - [ErrDecimalLoss] when the decimal part is lost and [WithDecimalLossReport] is used (example: 3.14 to int).
+//   - [ErrPrecisionLoss] when the value cannot be represented exactly and [WithPrecisionLossReport] is used (example: 1<<53 + 1 to float64).
 //
 // # General errors wrapped on conversion failure:
 //
 //   - [ErrConversionIssue] is always wrapped in the returned error when [Convert] fails (example "abc", -1, or 1000 to uint8).
+//
+// # Options
+//
+// The behavior of the conversion can be modified using [ConvertOption]s.
+// See [WithDecimalLossReport], [WithPrecisionLossReport], [WithSaturation], and [WithRounding].
 func Convert[NumOut Number, NumIn Number](orig NumIn, opts ...ConvertOption) (NumOut, error) {
+	return convert[NumOut](orig, newConvertOptions(opts...))
+}
+
+// ConvertSaturating converts any [Number] to the desired [Number] type,
+// clamping the value to the boundaries of the desired type instead of failing.
+//
+// clamped reports whether the value had to be clamped, so it can be ignored when it doesn't matter.
+//
+// # Behavior
+//
+//   - If the conversion is possible, the converted value is returned, and clamped is false.
+//   - If the value is greater than the maximum value of the desired type, this maximum value is returned (example: 1000 to uint8 gives 255).
+//   - If the value is less than the minimum value of the desired type, this minimum value is returned (example: -1 to uint8 gives 0).
+//   - [math.Inf] values are clamped the same way, including for float32 and float64 types.
+//   - [math.NaN] cannot be clamped to any boundary, zero is returned, and clamped is false.
+//     Use [Convert] to detect it, as it reports [ErrUnsupportedConversion].
+//
+// See [WithSaturation] to get the same behavior with [Convert].
+func ConvertSaturating[NumOut Number, NumIn Number](orig NumIn) (converted NumOut, clamped bool) {
+	converted, err := Convert[NumOut](orig)
+	if err == nil {
+		return converted, false
+	}
+
+	converted, err = saturate(converted, err)
+	if err != nil {
+		// NaN has no boundary to be clamped to
+		return 0, false
+	}
+	return converted, true
+}
+
+// ConvertWrapping converts any [Number] to the desired [Number] type the same way as a Go type conversion
+// would do, and reports whether the value overflowed.
+//
+// It is intended for code that deliberately relies on two's complement truncation, such as hashing or checksums,
+// so the wrapping becomes explicit instead of an unchecked type conversion.
+//
+// # Behavior
+//
+//   - converted is always the result of the Go type conversion NumOut(orig) (example: 256 to uint8 gives 0, -1 to uint8 gives 255).
+//   - overflowed is true when the value does not fit the desired type, using the same checks as [Convert].
+//
+// Please note that Go doesn't wrap floating-point values: converting an out of range float, [math.Inf], or [math.NaN]
+// to an integer gives an implementation-specific value. overflowed is reported as true for them.
+func ConvertWrapping[NumOut Number, NumIn Number](orig NumIn) (converted NumOut, overflowed bool) {
+	converted, err := Convert[NumOut](orig)
+	return converted, err != nil
+}
+
+// saturate replaces the converted value by the boundary of the desired type
+// when err is a range error, and clears the error.
+//
+// Other errors are returned as is.
+func saturate[NumOut Number](converted NumOut, err error) (NumOut, error) {
+	switch {
+	case errors.Is(err, ErrExceedMaximumValue):
+		return maxValue[NumOut](), nil
+	case errors.Is(err, ErrExceedMinimumValue):
+		return minValue[NumOut](), nil
+	}
+	return converted, err
+}
+
+// convert is the implementation of [Convert] once the options are parsed.
+func convert[NumOut Number, NumIn Number](orig NumIn, config *convertConfig) (NumOut, error) {
+	converted, err := convertNumber[NumOut](orig, config)
+	return applyErrorOptions(converted, err, config)
+}
+
+// applyErrorOptions applies the options changing how the errors are reported, such as [WithSaturation], [WithField],
+// and [WithMessageFormatter].
+func applyErrorOptions[NumOut Number](converted NumOut, err error, config *convertConfig) (NumOut, error) {
+	if err == nil {
+		return converted, nil
+	}
+
+	if config.saturate {
+		converted, err = saturate(converted, err)
+	}
+	if config.field != "" {
+		err = WrapField(config.field, err)
+	}
+	if config.formatter != nil {
+		err = withMessageFormatter(config.formatter, err)
+	}
+	return converted, err
+}
+
+// withMessageFormatter sets the formatter of the [ConversionError]s found in err.
+func withMessageFormatter(formatter MessageFormatter, err error) error {
+	return rewriteConversionErrors(err, func(e *ConversionError) {
+		e.formatter = formatter
+	}, func(err error) error {
+		return err
+	})
+}
+
+func convertNumber[NumOut Number, NumIn Number](orig NumIn, config *convertConfig) (NumOut, error) {
 	converted := NumOut(orig)
-	if isFloat64[NumIn]() {
+	if isFloat[NumIn]() {
 		floatOrig := float64(orig)
 		if math.IsInf(floatOrig, 1) || math.IsInf(floatOrig, -1) {
 			return converted, getRangeError[NumOut](orig)
 		}
 		if math.IsNaN(floatOrig) {
-			return converted, errorHelper[NumOut]{
-				value: orig,
-				err:   ErrUnsupportedConversion,
-			}
+			return converted, newConversionError[NumOut](orig, ErrUnsupportedConversion)
 		}
 	}
 
-	config := newConvertOptions(opts...)
-
-	if isFloat64[NumOut]() {
-		// float64 cannot overflow, so we don't have to worry about it
-		return converted, nil
-	}
-
-	if isFloat32[NumOut]() {
-		// check boundary
-		if math.Abs(float64(orig)) < math.MaxFloat32 {
-			// the value is within float32 range, there is no overflow
-			return converted, nil
+	if isFloat[NumOut]() {
+		// float64 cannot overflow, so only float32 has to be checked.
+		// The values slightly greater than math.MaxFloat32 are rounded down to it, so they are within range.
+		if isFloat32[NumOut]() && math.Abs(float64(orig)) >= float32Overflow {
+			return converted, getRangeError[NumOut](orig)
 		}
 
-		// TODO: check for numbers close to math.MaxFloat32
+		if config.reportPrecisionLoss && !isExactFloat(orig, float64(converted)) {
+			return converted, newConversionError[NumOut](orig, ErrPrecisionLoss)
+		}
 
-		return converted, getRangeError[NumOut](orig)
+		return converted, nil
 	}
 
 	base := orig
 	if isFloat[NumIn]() {
-		base = NumIn(math.Trunc(float64(orig)))
+		// the range is checked on the rounded value, so 255.6 rounded up overflows uint8
+		base = NumIn(config.rounding.round(float64(orig)))
+		converted = NumOut(base)
 	}
 
-	if !sameSign(orig, converted) {
-		if isFloat[NumIn]() && base == 0 {
-			// small fractional values like -0.1 that truncate to 0
-			// are considered to be within range, even if the original value is negative
-			return converted, nil
-		}
+	// the sign is checked on the rounded value:
+	// small fractional values like -0.1 that truncate to 0
+	// are considered to be within range, even if the original value is negative
+	if !sameSign(base, converted) {
 		return converted, getRangeError[NumOut](orig)
 	}
 
@@ -111,30 +210,50 @@ func Convert[NumOut Number, NumIn Number](orig NumIn, opts ...ConvertOption) (Nu
 
 	if config.reportDecimalLoss && isFloat[NumIn]() && !isFloat[NumOut]() {
 		if orig != cast {
-			return converted, errorHelper[NumOut]{
-				value: orig,
-				err:   ErrDecimalLoss,
-			}
+			return converted, newConversionError[NumOut](orig, ErrDecimalLoss)
 		}
 	}
 
 	return converted, nil
 }
 
+// float32Overflow is the smallest value rounded to infinity when converted to float32.
+//
+// It is halfway between math.MaxFloat32 and 2^128. As the mantissa of math.MaxFloat32 is odd,
+// this tie is rounded to 2^128, that float32 cannot represent.
+const float32Overflow = 0x1p128 - 0x1p103
+
+// isExactFloat reports whether f, the result of the conversion of orig to a floating-point type, is exactly orig.
+func isExactFloat[NumIn Number](orig NumIn, f float64) bool {
+	switch {
+	case isFloat[NumIn]():
+		return float64(orig) == f
+	case isNegative(orig):
+		// f cannot be less than math.MinInt64, as it is a power of two
+		return int64(f) == int64(orig)
+	case f >= 0x1p64:
+		// math.MaxUint64 is rounded up to 2^64, that cannot be converted back
+		return false
+	}
+	return uint64(f) == uint64(orig)
+}
+
 func getRangeError[NumOut Number, NumIn Number](value NumIn) error {
 	err := ErrExceedMaximumValue
 	if value < 0 {
 		err = ErrExceedMinimumValue
 	}
 
-	return errorHelper[NumOut]{
-		value: value,
-		err:   err,
-	}
+	return newConversionError[NumOut](value, err)
 }
 
 type convertConfig struct {
-	reportDecimalLoss bool
+	reportDecimalLoss   bool
+	reportPrecisionLoss bool
+	saturate            bool
+	rounding            RoundingMode
+	field               string
+	formatter           MessageFormatter
 }
 
 // ConvertOption is a function type used to set options for the [Convert] function.
@@ -143,6 +262,7 @@ type ConvertOption func(*convertConfig)
 func newConvertOptions(opts ...ConvertOption) *convertConfig {
 	po := &convertConfig{
 		reportDecimalLoss: false,
+		rounding:          RoundTruncate,
 	}
 
 	for _, opt := range opts {
@@ -165,3 +285,87 @@ func WithDecimalLossReport() ConvertOption {
 		cfg.reportDecimalLoss = true
 	}
 }
+
+// WithPrecisionLossReport is a [ConvertOption] that enables reporting of precision loss
+// when converting to a floating-point type.
+//
+// When this option is used, if the value cannot be represented exactly in the desired floating-point type,
+// the returned error will wrap [ErrPrecisionLoss].
+//
+// Examples include converting int64(1<<53 + 1) to float64, or 0.1 from float64 to float32.
+//
+// This option is also honored by [ConvertFromBigInt], [ConvertFromBigFloat], and [ConvertFromBigRat].
+//
+// Example:
+//
+//	value, err := Convert[float64](int64(1<<53+1), WithPrecisionLossReport())
+func WithPrecisionLossReport() ConvertOption {
+	return func(cfg *convertConfig) {
+		cfg.reportPrecisionLoss = true
+	}
+}
+
+// WithSaturation is a [ConvertOption] that clamps the value to the boundaries of the desired type
+// instead of failing when the value is out of its range.
+//
+// When this option is used, [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are no longer reported,
+// the maximum or minimum value of the desired type is returned instead.
+// Other errors, such as [ErrUnsupportedConversion] for [math.NaN], are still reported.
+//
+// Use [ConvertSaturating] if you need to know whether the value was clamped.
+//
+// Example:
+//
+//	value, err := Convert[uint8](1000, WithSaturation()) // 255, nil
+func WithSaturation() ConvertOption {
+	return func(cfg *convertConfig) {
+		cfg.saturate = true
+	}
+}
+
+// WithField is a [ConvertOption] that records the name of the field being converted in the returned error.
+//
+// It helps to find which value failed when converting many of them, such as the fields of a decoded payload.
+// The name is available in the Field of [ConversionError], and it is rendered in the error message.
+//
+// See [WrapField] to add the field to an error afterward, and [WithParseField] for [Parse].
+//
+// Example:
+//
+//	value, err := Convert[uint8](1000, WithField("spec.replicas"))
+//	// conversion issue: field "spec.replicas": 1000 (int) is greater than 255 (uint8): maximum value for this type exceeded
+func WithField(name string) ConvertOption {
+	return func(cfg *convertConfig) {
+		cfg.field = name
+	}
+}
+
+// WithMessageFormatter is a [ConvertOption] that sets the [MessageFormatter] used to build the messages
+// of the returned errors.
+//
+// It takes precedence over the formatter set with [SetMessageFormatter].
+// See [WithParseMessageFormatter] for [Parse].
+func WithMessageFormatter(formatter MessageFormatter) ConvertOption {
+	return func(cfg *convertConfig) {
+		cfg.formatter = formatter
+	}
+}
+
+// WithRounding is a [ConvertOption] that sets how floating-point values are rounded
+// when converted to an integer type.
+//
+// By default, values are truncated toward zero, see [RoundTruncate].
+//
+// The range of the desired type is checked on the rounded value, so a value that only overflows once rounded
+// is reported as such (example: 255.6 to uint8 with [RoundHalfUp]).
+//
+// When used with [WithDecimalLossReport], decimal loss is reported when the rounded value differs from the original one.
+//
+// Example:
+//
+//	value, err := Convert[int](2.5, WithRounding(RoundHalfToEven)) // 2, nil
+func WithRounding(mode RoundingMode) ConvertOption {
+	return func(cfg *convertConfig) {
+		cfg.rounding = mode
+	}
+}
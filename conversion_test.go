@@ -725,3 +725,308 @@ func ExampleWithDecimalLossReport() {
 	// 3 <nil>
 	// 3 conversion issue: decimal loss during conversion
 }
+
+type MapConvertSaturatingTest[TypeInput safecast.Number, TypeOutput safecast.Number] struct {
+	Input           TypeInput
+	ExpectedOutput  TypeOutput
+	ExpectedClamped bool
+}
+
+func (mt MapConvertSaturatingTest[I, O]) Run(t *testing.T) {
+	t.Helper()
+
+	out, clamped := safecast.ConvertSaturating[O](mt.Input)
+	assertEqual(t, mt.ExpectedOutput, out)
+	assertEqual(t, mt.ExpectedClamped, clamped)
+}
+
+func TestConvertSaturating(t *testing.T) {
+	t.Run("within range", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"int to uint8":        MapConvertSaturatingTest[int, uint8]{Input: 42, ExpectedOutput: 42},
+			"int64 to int8":       MapConvertSaturatingTest[int64, int8]{Input: -42, ExpectedOutput: -42},
+			"uint64 to int32":     MapConvertSaturatingTest[uint64, int32]{Input: 42, ExpectedOutput: 42},
+			"float64 to int16":    MapConvertSaturatingTest[float64, int16]{Input: 42.9, ExpectedOutput: 42},
+			"float64 to float32":  MapConvertSaturatingTest[float64, float32]{Input: 42.5, ExpectedOutput: 42.5},
+			"float32 to float64":  MapConvertSaturatingTest[float32, float64]{Input: 42.5, ExpectedOutput: 42.5},
+			"uint8 to uintptr":    MapConvertSaturatingTest[uint8, uintptr]{Input: 42, ExpectedOutput: 42},
+			"negative fraction":   MapConvertSaturatingTest[float64, uint8]{Input: -0.5, ExpectedOutput: 0},
+			"maximum value":       MapConvertSaturatingTest[int, uint8]{Input: math.MaxUint8, ExpectedOutput: math.MaxUint8},
+			"minimum value":       MapConvertSaturatingTest[int, int8]{Input: math.MinInt8, ExpectedOutput: math.MinInt8},
+			"maximum float value": MapConvertSaturatingTest[float64, float64]{Input: math.MaxFloat64, ExpectedOutput: math.MaxFloat64},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+
+	t.Run("clamped to maximum", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"int to int8":          MapConvertSaturatingTest[int, int8]{Input: 1000, ExpectedOutput: math.MaxInt8, ExpectedClamped: true},
+			"int to uint8":         MapConvertSaturatingTest[int, uint8]{Input: 1000, ExpectedOutput: math.MaxUint8, ExpectedClamped: true},
+			"int64 to int16":       MapConvertSaturatingTest[int64, int16]{Input: math.MaxInt64, ExpectedOutput: math.MaxInt16, ExpectedClamped: true},
+			"uint64 to int64":      MapConvertSaturatingTest[uint64, int64]{Input: math.MaxUint64, ExpectedOutput: math.MaxInt64, ExpectedClamped: true},
+			"uint64 to uint32":     MapConvertSaturatingTest[uint64, uint32]{Input: math.MaxUint64, ExpectedOutput: math.MaxUint32, ExpectedClamped: true},
+			"float64 to uint16":    MapConvertSaturatingTest[float64, uint16]{Input: 65535.5 + 1, ExpectedOutput: math.MaxUint16, ExpectedClamped: true},
+			"float64 to uint64":    MapConvertSaturatingTest[float64, uint64]{Input: math.MaxUint64 * 1.01, ExpectedOutput: math.MaxUint64, ExpectedClamped: true},
+			"float64 to float32":   MapConvertSaturatingTest[float64, float32]{Input: math.MaxFloat32 * 1.01, ExpectedOutput: math.MaxFloat32, ExpectedClamped: true},
+			"+Inf to float64":      MapConvertSaturatingTest[float64, float64]{Input: math.Inf(1), ExpectedOutput: math.MaxFloat64, ExpectedClamped: true},
+			"+Inf to float32":      MapConvertSaturatingTest[float64, float32]{Input: math.Inf(1), ExpectedOutput: math.MaxFloat32, ExpectedClamped: true},
+			"+Inf to int32":        MapConvertSaturatingTest[float64, int32]{Input: math.Inf(1), ExpectedOutput: math.MaxInt32, ExpectedClamped: true},
+			"float32 +Inf to int8": MapConvertSaturatingTest[float32, int8]{Input: float32(math.Inf(1)), ExpectedOutput: math.MaxInt8, ExpectedClamped: true},
+			"float32 +Inf to uint": MapConvertSaturatingTest[float32, uint]{Input: float32(math.Inf(1)), ExpectedOutput: math.MaxUint, ExpectedClamped: true},
+			"float64 to uintptr":   MapConvertSaturatingTest[float64, uintptr]{Input: math.MaxUint64 * 1.01, ExpectedOutput: ^uintptr(0), ExpectedClamped: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+
+	t.Run("clamped to minimum", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"int to int8":             MapConvertSaturatingTest[int, int8]{Input: -1000, ExpectedOutput: math.MinInt8, ExpectedClamped: true},
+			"int to uint8":            MapConvertSaturatingTest[int, uint8]{Input: -1, ExpectedOutput: 0, ExpectedClamped: true},
+			"int64 to int32":          MapConvertSaturatingTest[int64, int32]{Input: math.MinInt64, ExpectedOutput: math.MinInt32, ExpectedClamped: true},
+			"int8 to uint64":          MapConvertSaturatingTest[int8, uint64]{Input: -1, ExpectedOutput: 0, ExpectedClamped: true},
+			"float64 to int8":         MapConvertSaturatingTest[float64, int8]{Input: -129.5, ExpectedOutput: math.MinInt8, ExpectedClamped: true},
+			"float64 to float32":      MapConvertSaturatingTest[float64, float32]{Input: -math.MaxFloat32 * 1.01, ExpectedOutput: -math.MaxFloat32, ExpectedClamped: true},
+			"-Inf to float64":         MapConvertSaturatingTest[float64, float64]{Input: math.Inf(-1), ExpectedOutput: -math.MaxFloat64, ExpectedClamped: true},
+			"-Inf to float32":         MapConvertSaturatingTest[float64, float32]{Input: math.Inf(-1), ExpectedOutput: -math.MaxFloat32, ExpectedClamped: true},
+			"-Inf to int64":           MapConvertSaturatingTest[float64, int64]{Input: math.Inf(-1), ExpectedOutput: math.MinInt64, ExpectedClamped: true},
+			"-Inf to uint16":          MapConvertSaturatingTest[float64, uint16]{Input: math.Inf(-1), ExpectedOutput: 0, ExpectedClamped: true},
+			"float32 -Inf to int16":   MapConvertSaturatingTest[float32, int16]{Input: float32(math.Inf(-1)), ExpectedOutput: math.MinInt16, ExpectedClamped: true},
+			"float32 -Inf to float32": MapConvertSaturatingTest[float32, float32]{Input: float32(math.Inf(-1)), ExpectedOutput: -math.MaxFloat32, ExpectedClamped: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"float64 NaN to int":  MapConvertSaturatingTest[float64, int]{Input: math.NaN(), ExpectedOutput: 0, ExpectedClamped: false},
+			"float32 NaN to int8": MapConvertSaturatingTest[float32, int8]{Input: float32(math.NaN()), ExpectedOutput: 0, ExpectedClamped: false},
+			"NaN to float64":      MapConvertSaturatingTest[float64, float64]{Input: math.NaN(), ExpectedOutput: 0, ExpectedClamped: false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+}
+
+func TestConvert_withSaturation(t *testing.T) {
+	withSaturation := []safecast.ConvertOption{safecast.WithSaturation()}
+
+	for name, tt := range map[string]TestRunner{
+		"within range":        MapTest[int, uint8]{Input: 42, Options: withSaturation, ExpectedOutput: 42},
+		"above maximum":       MapTest[int, uint8]{Input: 1000, Options: withSaturation, ExpectedOutput: math.MaxUint8},
+		"below minimum":       MapTest[int, uint8]{Input: -1, Options: withSaturation, ExpectedOutput: 0},
+		"signed below":        MapTest[int64, int16]{Input: math.MinInt64, Options: withSaturation, ExpectedOutput: math.MinInt16},
+		"float above":         MapTest[float64, int8]{Input: 128.5, Options: withSaturation, ExpectedOutput: math.MaxInt8},
+		"float32 above":       MapTest[float64, float32]{Input: math.MaxFloat64, Options: withSaturation, ExpectedOutput: math.MaxFloat32},
+		"+Inf to float64":     MapTest[float64, float64]{Input: math.Inf(1), Options: withSaturation, ExpectedOutput: math.MaxFloat64},
+		"-Inf to float32":     MapTest[float64, float32]{Input: math.Inf(-1), Options: withSaturation, ExpectedOutput: -math.MaxFloat32},
+		"float32 +Inf to int": MapTest[float32, uint32]{Input: float32(math.Inf(1)), Options: withSaturation, ExpectedOutput: math.MaxUint32},
+		"NaN is still reported": MapTest[float64, int]{
+			Input:         math.NaN(),
+			Options:       withSaturation,
+			ExpectedError: safecast.ErrUnsupportedConversion,
+		},
+		"float32 NaN is still reported": MapTest[float32, float64]{
+			Input:         float32(math.NaN()),
+			Options:       withSaturation,
+			ExpectedError: safecast.ErrUnsupportedConversion,
+		},
+		"decimal loss is still reported": MapTest[float64, uint8]{
+			Input:         3.14,
+			Options:       []safecast.ConvertOption{safecast.WithSaturation(), safecast.WithDecimalLossReport()},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"decimal loss is not reported when clamped": MapTest[float64, uint8]{
+			Input:          300.14,
+			Options:        []safecast.ConvertOption{safecast.WithSaturation(), safecast.WithDecimalLossReport()},
+			ExpectedOutput: math.MaxUint8,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestConvert_float32Boundary(t *testing.T) {
+	// the values up to halfway between math.MaxFloat32 and 2^128 are rounded to math.MaxFloat32
+	// by the conversion, the other ones are rounded to infinity
+	overflow := math.Ldexp(1, 128) - math.Ldexp(1, 103)
+
+	for name, tt := range map[string]TestRunner{
+		"float32 max":              MapTest[float32, float32]{Input: math.MaxFloat32, ExpectedOutput: math.MaxFloat32},
+		"float32 min":              MapTest[float32, float32]{Input: -math.MaxFloat32, ExpectedOutput: -math.MaxFloat32},
+		"float64 max":              MapTest[float64, float32]{Input: math.MaxFloat32, ExpectedOutput: math.MaxFloat32},
+		"float64 min":              MapTest[float64, float32]{Input: -math.MaxFloat32, ExpectedOutput: -math.MaxFloat32},
+		"rounded down to max":      MapTest[float64, float32]{Input: math.Nextafter(overflow, 0), ExpectedOutput: math.MaxFloat32},
+		"rounded up to min":        MapTest[float64, float32]{Input: -math.Nextafter(overflow, 0), ExpectedOutput: -math.MaxFloat32},
+		"rounded up to infinity":   MapTest[float64, float32]{Input: overflow, ExpectedError: safecast.ErrExceedMaximumValue},
+		"rounded down to infinity": MapTest[float64, float32]{Input: -overflow, ExpectedError: safecast.ErrExceedMinimumValue},
+		"uint64 max":               MapTest[uint64, float32]{Input: math.MaxUint64, ExpectedOutput: 1 << 64},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestConvert_withPrecisionLossReport(t *testing.T) {
+	withPrecisionLoss := []safecast.ConvertOption{safecast.WithPrecisionLossReport()}
+
+	for name, tt := range map[string]TestRunner{
+		"int64 exact":               MapTest[int64, float64]{Input: 1 << 53, Options: withPrecisionLoss, ExpectedOutput: 1 << 53},
+		"int64 rounded":             MapTest[int64, float64]{Input: 1<<53 + 1, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"negative int64 exact":      MapTest[int64, float64]{Input: -1 << 53, Options: withPrecisionLoss, ExpectedOutput: -1 << 53},
+		"negative int64 rounded":    MapTest[int64, float64]{Input: -1<<53 - 1, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"int64 min":                 MapTest[int64, float64]{Input: math.MinInt64, Options: withPrecisionLoss, ExpectedOutput: math.MinInt64},
+		"int64 max":                 MapTest[int64, float64]{Input: math.MaxInt64, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"uint64 exact":              MapTest[uint64, float64]{Input: 1 << 63, Options: withPrecisionLoss, ExpectedOutput: 1 << 63},
+		"uint64 max":                MapTest[uint64, float64]{Input: math.MaxUint64, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"uint32 to float64":         MapTest[uint32, float64]{Input: math.MaxUint32, Options: withPrecisionLoss, ExpectedOutput: math.MaxUint32},
+		"int32 to float32 exact":    MapTest[int32, float32]{Input: 1 << 24, Options: withPrecisionLoss, ExpectedOutput: 1 << 24},
+		"int32 to float32 rounded":  MapTest[int32, float32]{Input: 1<<24 + 1, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"uint64 max to float32":     MapTest[uint64, float32]{Input: math.MaxUint64, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"int8 to float32":           MapTest[int8, float32]{Input: math.MinInt8, Options: withPrecisionLoss, ExpectedOutput: math.MinInt8},
+		"float64 to float32 exact":  MapTest[float64, float32]{Input: 0.5, Options: withPrecisionLoss, ExpectedOutput: 0.5},
+		"float64 to float32 lost":   MapTest[float64, float32]{Input: 0.1, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"float64 near float32 max":  MapTest[float64, float32]{Input: math.MaxFloat32 + 1e23, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"float64 tiny":              MapTest[float64, float32]{Input: math.SmallestNonzeroFloat64, Options: withPrecisionLoss, ExpectedError: safecast.ErrPrecisionLoss},
+		"float32 to float64":        MapTest[float32, float64]{Input: 0.1, Options: withPrecisionLoss, ExpectedOutput: float64(float32(0.1))},
+		"float64 to float64":        MapTest[float64, float64]{Input: 0.1, Options: withPrecisionLoss, ExpectedOutput: 0.1},
+		"not reported by default":   MapTest[int64, float64]{Input: 1<<53 + 1, ExpectedOutput: 1 << 53},
+		"range checked first":       MapTest[float64, float32]{Input: math.MaxFloat64, Options: withPrecisionLoss, ExpectedError: safecast.ErrExceedMaximumValue},
+		"not reported for integers": MapTest[float64, int]{Input: 0.1, Options: withPrecisionLoss, ExpectedOutput: 0},
+		"NaN is still reported":     MapTest[float64, float32]{Input: math.NaN(), Options: withPrecisionLoss, ExpectedError: safecast.ErrUnsupportedConversion},
+		"message": MapTest[int64, float64]{
+			Input:         1<<53 + 1,
+			Options:       withPrecisionLoss,
+			ExpectedError: safecast.ErrPrecisionLoss,
+			ErrorContains: "conversion issue: precision loss during conversion",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func ExampleConvertSaturating() {
+	for _, v := range []int{42, 1000, -1} {
+		out, clamped := safecast.ConvertSaturating[uint8](v)
+		fmt.Println(out, clamped)
+	}
+
+	f, clamped := safecast.ConvertSaturating[float32](math.Inf(1))
+	fmt.Println(f, clamped)
+
+	// Output:
+	// 42 false
+	// 255 true
+	// 0 true
+	// 3.4028235e+38 true
+}
+
+func ExampleWithSaturation() {
+	// By default, values out of range are reported as errors
+	val1, err1 := safecast.Convert[int8](300)
+	fmt.Println(val1, err1)
+
+	// Using the WithSaturation option, values are clamped to the boundaries of the type
+	val2, err2 := safecast.Convert[int8](300, safecast.WithSaturation())
+	fmt.Println(val2, err2)
+
+	val3, err3 := safecast.Convert[int8](-300, safecast.WithSaturation())
+	fmt.Println(val3, err3)
+
+	// Output:
+	// 44 conversion issue: 300 (int) is greater than 127 (int8): maximum value for this type exceeded
+	// 127 <nil>
+	// -128 <nil>
+}
+
+type MapConvertWrappingTest[TypeInput safecast.Number, TypeOutput safecast.Number] struct {
+	Input              TypeInput
+	ExpectedOutput     TypeOutput
+	ExpectedOverflowed bool
+}
+
+func (mt MapConvertWrappingTest[I, O]) Run(t *testing.T) {
+	t.Helper()
+
+	out, overflowed := safecast.ConvertWrapping[O](mt.Input)
+	assertEqual(t, mt.ExpectedOutput, out)
+	assertEqual(t, mt.ExpectedOverflowed, overflowed)
+}
+
+func TestConvertWrapping(t *testing.T) {
+	t.Run("no overflow", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"int to uint8":       MapConvertWrappingTest[int, uint8]{Input: 42, ExpectedOutput: 42},
+			"int64 to int8":      MapConvertWrappingTest[int64, int8]{Input: -42, ExpectedOutput: -42},
+			"uint64 to uint32":   MapConvertWrappingTest[uint64, uint32]{Input: math.MaxUint32, ExpectedOutput: math.MaxUint32},
+			"int8 to int64":      MapConvertWrappingTest[int8, int64]{Input: math.MinInt8, ExpectedOutput: math.MinInt8},
+			"float64 to int":     MapConvertWrappingTest[float64, int]{Input: -2.9, ExpectedOutput: -2},
+			"float64 to float32": MapConvertWrappingTest[float64, float32]{Input: 0.5, ExpectedOutput: 0.5},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		for name, tt := range map[string]TestRunner{
+			"int to uint8":       MapConvertWrappingTest[int, uint8]{Input: 256, ExpectedOutput: 0, ExpectedOverflowed: true},
+			"int to uint8 (-1)":  MapConvertWrappingTest[int, uint8]{Input: -1, ExpectedOutput: 255, ExpectedOverflowed: true},
+			"uint8 to int8":      MapConvertWrappingTest[uint8, int8]{Input: 200, ExpectedOutput: -56, ExpectedOverflowed: true},
+			"int64 to int32":     MapConvertWrappingTest[int64, int32]{Input: math.MaxInt32 + 2, ExpectedOutput: math.MinInt32 + 1, ExpectedOverflowed: true},
+			"uint64 to uint16":   MapConvertWrappingTest[uint64, uint16]{Input: 0x12345, ExpectedOutput: 0x2345, ExpectedOverflowed: true},
+			"int8 to uint64":     MapConvertWrappingTest[int8, uint64]{Input: -1, ExpectedOutput: math.MaxUint64, ExpectedOverflowed: true},
+			"uint64 to int64":    MapConvertWrappingTest[uint64, int64]{Input: math.MaxUint64, ExpectedOutput: -1, ExpectedOverflowed: true},
+			"int16 to uintptr":   MapConvertWrappingTest[int16, uintptr]{Input: -1, ExpectedOutput: ^uintptr(0), ExpectedOverflowed: true},
+			"float64 to float32": MapConvertWrappingTest[float64, float32]{Input: math.MaxFloat64, ExpectedOutput: float32(math.Inf(1)), ExpectedOverflowed: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				tt.Run(t)
+			})
+		}
+	})
+
+	t.Run("float values are reported as overflowed", func(t *testing.T) {
+		// the converted value is implementation-specific, so only the flag is checked
+		for name, input := range map[string]float64{
+			"out of range": 1e20,
+			"+Inf":         math.Inf(1),
+			"-Inf":         math.Inf(-1),
+			"NaN":          math.NaN(),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, overflowed := safecast.ConvertWrapping[int32](input)
+				assertEqual(t, true, overflowed)
+			})
+		}
+	})
+}
+
+func ExampleConvertWrapping() {
+	var checksum uint32 = 0xFFFFFFFF
+	sum, overflowed := safecast.ConvertWrapping[uint16](checksum)
+	fmt.Println(sum, overflowed)
+
+	sum, overflowed = safecast.ConvertWrapping[uint16](uint32(0xFFFF))
+	fmt.Println(sum, overflowed)
+
+	// Output:
+	// 65535 true
+	// 65535 false
+}
@@ -0,0 +1,133 @@
+package safecast
+
+import (
+	"math/big"
+	"strings"
+)
+
+// decimalLiteral is a decimal number with an optional fractional part and exponent, such as "-1.5e2",
+// split so its exact value can be computed without going through a floating-point approximation.
+type decimalLiteral struct {
+	negative bool
+	digits   string // the digits of the integer and fractional parts, without the leading zeros
+	exponent int64  // the power of 10 applied to digits
+}
+
+const (
+	// maxExponent bounds the exponent read from a literal, the larger ones don't change the result
+	// as they are clamped by [decimalLiteral.rat] anyway.
+	maxExponent = 1_000_000_000
+
+	// maxIntegerDigits is the number of digits of the largest values of the integer types (math.MaxUint64),
+	// the values with more digits in their integer part are out of range whatever the type.
+	maxIntegerDigits = 20
+)
+
+// parseDecimalLiteral parses a decimal number such as "42", "-1.5", "1e3", or ".5E-2".
+//
+// The special values accepted by [strconv.ParseFloat], such as "Inf" or hexadecimal floats, are not supported.
+func parseDecimalLiteral(s string) (decimalLiteral, bool) {
+	var d decimalLiteral
+
+	switch {
+	case strings.HasPrefix(s, "-"):
+		d.negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i+1:]
+		if !parseExponent(exponent, &d.exponent) {
+			return decimalLiteral{}, false
+		}
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return decimalLiteral{}, false
+	}
+
+	d.digits = strings.TrimLeft(integer+fraction, "0")
+	d.exponent -= int64(len(fraction))
+	return d, true
+}
+
+// parseExponent parses the exponent of a decimal literal, with an optional sign.
+func parseExponent(s string, exponent *int64) bool {
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if s == "" || !isDigits(s) {
+		return false
+	}
+
+	var e int64
+	for _, r := range s {
+		if e < maxExponent {
+			e = e*10 + int64(r-'0')
+		}
+	}
+
+	if negative {
+		e = -e
+	}
+	*exponent = e
+	return true
+}
+
+// rat returns the exact value of the literal.
+//
+// The very large and very small values are clamped, so the exponent of a literal such as "1e1000000000"
+// doesn't lead to a huge computation: a value whose integer part has more than [maxIntegerDigits] digits
+// is replaced by another one out of the range of all the integer types, and a non-zero value
+// with more than [maxIntegerDigits] leading zeros in its fractional part is replaced by another one
+// rounded the same way by all the [RoundingMode]s.
+//
+// The digits are bounded as well: only the first digit of the fractional part is kept,
+// followed by a digit 1 if the dropped digits are not all zeros, which is enough to round the value
+// and to report the decimal loss, so a literal with millions of digits stays cheap to convert.
+func (d decimalLiteral) rat() *big.Rat {
+	if d.digits == "" {
+		return new(big.Rat)
+	}
+
+	// the number of digits of the integer part, negative when there are leading zeros in the fractional part
+	magnitude := int64(len(d.digits)) + d.exponent
+	switch {
+	case magnitude > maxIntegerDigits+1:
+		d.digits, d.exponent = "1", maxIntegerDigits+1
+	case magnitude < -maxIntegerDigits-1:
+		d.digits, d.exponent = "1", -maxIntegerDigits-2
+	}
+
+	// the digits of the integer part, and the first digit of the fractional part
+	if keep := max(magnitude+1, 1); int64(len(d.digits)) > keep+1 {
+		dropped := d.digits[keep:]
+		d.digits = d.digits[:keep]
+		d.exponent += int64(len(dropped))
+		if strings.Trim(dropped, "0") != "" {
+			d.digits += "1"
+			d.exponent--
+		}
+	}
+
+	mantissa, _ := new(big.Int).SetString(d.digits, 10)
+	if d.negative {
+		mantissa.Neg(mantissa)
+	}
+
+	r := new(big.Rat).SetInt(mantissa)
+	if d.exponent >= 0 {
+		return r.Mul(r, powerOf(10, d.exponent))
+	}
+	return r.Quo(r, powerOf(10, -d.exponent))
+}
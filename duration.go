@@ -0,0 +1,304 @@
+package safecast
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ParseDurationAs parses a duration string, such as "1h30m" or "250ms", as [time.ParseDuration] does,
+// and converts it to the desired [Number] type, as a number of unit.
+//
+// # Behavior
+//
+//   - The duration is divided by unit exactly, then converted like [ConvertFromBigRat] does:
+//     the value is truncated toward zero for integer types (example: "1500ms" in seconds gives 1).
+//   - [WithDecimalLossReport] reports the durations that are not a whole number of unit (example: "1500ms" in seconds).
+//   - [WithRounding] sets how the durations that are not a whole number of unit are rounded.
+//   - The durations beyond the range of [time.Duration], that is about 292 years, are supported
+//     (example: "3000000h" in hours gives 3000000).
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the duration in unit
+//     is outside the range of the desired type (example: "1000h" in milliseconds to int32).
+//   - [ErrStringConversion] is wrapped when the string is not a valid duration.
+//   - [ErrUnsupportedConversion] is wrapped when unit is not positive.
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// # Options
+//
+// The [ConvertOption]s, such as [WithRounding] or [WithSaturation], are set with [WithConvertOptions].
+// [WithParseField] and [WithParseMessageFormatter] change how the errors are reported, as with [Parse].
+//
+// Example:
+//
+//	timeout, err := ParseDurationAs[int32]("1h30m", time.Millisecond) // 5400000, nil
+func ParseDurationAs[NumOut Number](s string, unit time.Duration, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		return parseDurationAs[NumOut](s, unit, options)
+	})
+}
+
+func parseDurationAs[NumOut Number](orig string, unit time.Duration, options *parseConfig) (NumOut, error) {
+	config := newConvertOptions(options.convertOptions...)
+
+	d, err := time.ParseDuration(orig)
+	if err == nil {
+		// the original string is reported, as the duration doesn't appear in it
+		return convertDuration[NumOut](orig, d, unit, config)
+	}
+
+	// the durations beyond the range of time.Duration, such as "3000000h", are rejected by time.ParseDuration
+	ns, ok := parseExactDuration(orig)
+	if !ok {
+		return applyErrorOptions[NumOut](0, newConversionError[NumOut](orig, ErrStringConversion), config)
+	}
+	if unit <= 0 {
+		return applyErrorOptions[NumOut](0, newConversionError[NumOut](unit, ErrUnsupportedConversion), config)
+	}
+
+	converted, err := convertFromBigRat[NumOut](ns.Quo(ns, big.NewRat(int64(unit), 1)), config)
+	converted, err = applyErrorOptions(converted, err, config)
+	if err != nil {
+		return converted, withValue(orig, err)
+	}
+	return converted, nil
+}
+
+// durationUnits are the units accepted by [time.ParseDuration], in nanoseconds.
+var durationUnits = map[string]int64{
+	"ns": 1,
+	"us": int64(time.Microsecond),
+	"µs": int64(time.Microsecond), // U+00B5 micro sign
+	"μs": int64(time.Microsecond), // U+03BC Greek letter mu
+	"ms": int64(time.Millisecond),
+	"s":  int64(time.Second),
+	"m":  int64(time.Minute),
+	"h":  int64(time.Hour),
+}
+
+// maxDurationDigits bounds the digits of each number of a duration read by [parseExactDuration].
+//
+// The integer parts with more digits are out of the range of all the types whatever the unit,
+// and the fractional digits beyond it are replaced by a digit 1 when they are not all zeros,
+// so the decimal loss is still reported.
+const maxDurationDigits = 400
+
+// parseExactDuration parses s as [time.ParseDuration] does, such as "1h30m", and returns its exact number
+// of nanoseconds, without the limit of the range of [time.Duration].
+func parseExactDuration(s string) (*big.Rat, bool) {
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if s == "0" {
+		return new(big.Rat), true
+	}
+	if s == "" {
+		return nil, false
+	}
+
+	isNumber := func(r rune) bool { return r == '.' || r >= '0' && r <= '9' }
+
+	total := new(big.Rat)
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return !isNumber(r) })
+		if i <= 0 {
+			// the number or the unit is missing
+			return nil, false
+		}
+		number := s[:i]
+		s = s[i:]
+
+		j := strings.IndexFunc(s, isNumber)
+		if j < 0 {
+			j = len(s)
+		}
+		multiplier, ok := durationUnits[s[:j]]
+		if !ok {
+			return nil, false
+		}
+		s = s[j:]
+
+		value, ok := parseDurationNumber(number)
+		if !ok {
+			return nil, false
+		}
+		total.Add(total, value.Mul(value, big.NewRat(multiplier, 1)))
+	}
+
+	if negative {
+		total.Neg(total)
+	}
+	return total, true
+}
+
+// parseDurationNumber parses a number of a duration, such as "1.5" in "1.5h", bounded by [maxDurationDigits].
+func parseDurationNumber(s string) (*big.Rat, bool) {
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" || !isDigits(integer) || !isDigits(fraction) {
+		return nil, false
+	}
+
+	integer = strings.TrimLeft(integer, "0")
+	if len(integer) > maxDurationDigits {
+		integer = "1" + strings.Repeat("0", maxDurationDigits)
+	}
+	if len(fraction) > maxDurationDigits {
+		dropped := fraction[maxDurationDigits:]
+		fraction = fraction[:maxDurationDigits]
+		if strings.Trim(dropped, "0") != "" {
+			fraction += "1"
+		}
+	}
+
+	mantissa, _ := new(big.Int).SetString("0"+integer+fraction, 10)
+	value := new(big.Rat).SetInt(mantissa)
+	return value.Quo(value, powerOf(10, int64(len(fraction)))), true
+}
+
+// convertDuration converts d to the desired type, as a number of unit.
+//
+// value is the original value, used in error messages.
+func convertDuration[NumOut Number](value any, d time.Duration, unit time.Duration, config *convertConfig) (NumOut, error) {
+	if unit <= 0 {
+		return applyErrorOptions[NumOut](0, newConversionError[NumOut](unit, ErrUnsupportedConversion), config)
+	}
+
+	var (
+		converted NumOut
+		err       error
+	)
+	if d%unit == 0 {
+		// the most common case doesn't need an arbitrary-precision division
+		converted, err = convert[NumOut](int64(d/unit), config)
+	} else {
+		converted, err = convertFromBigRat[NumOut](big.NewRat(int64(d), int64(unit)), config)
+		converted, err = applyErrorOptions(converted, err, config)
+	}
+
+	if err != nil {
+		return converted, withValue(value, err)
+	}
+	return converted, nil
+}
+
+// DurationTo converts d to the desired [Number] type, as a number of unit, such as milliseconds.
+//
+// It replaces int32(d / time.Millisecond), that silently overflows.
+//
+// # Behavior
+//
+//   - The duration is divided by unit exactly, then converted like [ConvertFromBigRat] does:
+//     the value is truncated toward zero for integer types, as the Go division does.
+//   - [WithDecimalLossReport] reports the durations that are not a whole number of unit.
+//   - [WithRounding] sets how the durations that are not a whole number of unit are rounded.
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the duration in unit
+//     is outside the range of the desired type (example: 1000h in milliseconds to int32).
+//   - [ErrUnsupportedConversion] is wrapped when unit is not positive.
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// Example:
+//
+//	ms, err := DurationTo[int32](d, time.Millisecond)
+func DurationTo[NumOut Number](d time.Duration, unit time.Duration, opts ...ConvertOption) (NumOut, error) {
+	return convertDuration[NumOut](d, d, unit, newConvertOptions(opts...))
+}
+
+// DurationFrom converts v, a number of unit, to a [time.Duration].
+//
+// It replaces time.Duration(v) * time.Millisecond, that silently overflows.
+//
+// # Behavior
+//
+//   - Floating-point values are truncated toward zero to the nanosecond (example: 1.5 in seconds gives 1.5s).
+//   - [WithRounding] sets how the floating-point values that are not a whole number of nanoseconds are rounded.
+//   - [WithDecimalLossReport] reports the floating-point values that are not a whole number of nanoseconds.
+//   - [WithSaturation] clamps the durations that exceed the range of [time.Duration].
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the duration exceeds the range of [time.Duration],
+//     that is about 292 years.
+//   - [ErrUnsupportedConversion] is wrapped when unit is not positive, or when v is [math.NaN].
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// Example:
+//
+//	d, err := DurationFrom(timeoutMs, time.Millisecond)
+func DurationFrom[NumIn Number](v NumIn, unit time.Duration, opts ...ConvertOption) (time.Duration, error) {
+	config := newConvertOptions(opts...)
+
+	if unit <= 0 {
+		return applyErrorOptions[time.Duration](0, newConversionError[time.Duration](unit, ErrUnsupportedConversion), config)
+	}
+
+	if !isFloat[NumIn]() {
+		d, err := MulAs[time.Duration](v, unit)
+		return applyErrorOptions(d, err, config)
+	}
+
+	// the product is rounded according to the options, instead of being truncated by MulAs
+	d, err := convert[time.Duration](float64(v)*float64(unit), config)
+	return d, rewriteConversionErrors(err, func(e *ConversionError) {
+		e.Value, e.From = nil, ""
+		e.Operation = fmt.Sprintf("%v (%T) * %v (%T)", v, v, unit, unit)
+	}, func(err error) error {
+		return err
+	})
+}
+
+// UnixTo converts t to the desired [Number] type, as the number of precision elapsed since January 1, 1970 UTC,
+// such as the number of seconds with [time.Second].
+//
+// It replaces uint32(t.Unix()), that silently overflows (example: in 2106 for uint32, or in 2038 for int32).
+//
+// # Behavior
+//
+//   - The result is the same as [time.Time.Unix], [time.Time.UnixMilli], [time.Time.UnixMicro], or [time.Time.UnixNano]
+//     for the matching precision, when it fits the desired type.
+//   - The values are rounded toward negative infinity by default, as [time.Time.Unix] does for times before 1970.
+//     [WithRounding] sets another rounding mode.
+//   - [WithDecimalLossReport] reports the times that are not a whole number of precision.
+//   - The times far from 1970, that overflow [time.Time.UnixNano], are supported.
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the result is outside
+//     the range of the desired type (example: 2038-01-19 03:14:08 UTC in seconds to int32).
+//   - [ErrUnsupportedConversion] is wrapped when precision is not positive.
+//   - [ErrConversionIssue] is always wrapped on failure.
+func UnixTo[NumOut Number](t time.Time, precision time.Duration, opts ...ConvertOption) (NumOut, error) {
+	config := newConvertOptions(append([]ConvertOption{WithRounding(RoundFloor)}, opts...)...)
+
+	sec, nsec := t.Unix(), int64(t.Nanosecond())
+	if sec > math.MinInt64/int64(time.Second) && sec < math.MaxInt64/int64(time.Second) {
+		// the time fits in a time.Duration since January 1, 1970 UTC
+		return convertDuration[NumOut](t, time.Duration(sec*int64(time.Second)+nsec), precision, config)
+	}
+
+	if precision <= 0 {
+		return applyErrorOptions[NumOut](0, newConversionError[NumOut](precision, ErrUnsupportedConversion), config)
+	}
+
+	ns := new(big.Int).Mul(big.NewInt(sec), big.NewInt(int64(time.Second)))
+	ns.Add(ns, big.NewInt(nsec))
+
+	converted, err := convertFromBigRat[NumOut](new(big.Rat).SetFrac(ns, big.NewInt(int64(precision))), config)
+	converted, err = applyErrorOptions(converted, err, config)
+	if err != nil {
+		return converted, withValue(t, err)
+	}
+	return converted, nil
+}
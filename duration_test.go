@@ -0,0 +1,350 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+// parseDurationAs returns a function calling [safecast.ParseDurationAs] with unit, to be used with [MapTestParse].
+func parseDurationAs[T safecast.Number](unit time.Duration) func(s string, opts ...safecast.ParseOption) (T, error) {
+	return func(s string, opts ...safecast.ParseOption) (T, error) {
+		return safecast.ParseDurationAs[T](s, unit, opts...)
+	}
+}
+
+func TestParseDurationAs(t *testing.T) {
+	decimalLoss := []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())}
+
+	for name, c := range map[string]TestRunner{
+		"milliseconds":        MapTestParse[int32]{Parse: parseDurationAs[int32](time.Millisecond), Input: "1h30m", ExpectedOutput: 5_400_000},
+		"seconds":             MapTestParse[uint16]{Parse: parseDurationAs[uint16](time.Second), Input: "250ms", ExpectedOutput: 0},
+		"seconds exact":       MapTestParse[uint16]{Parse: parseDurationAs[uint16](time.Second), Input: "18h12m15s", ExpectedOutput: math.MaxUint16},
+		"negative":            MapTestParse[int64]{Parse: parseDurationAs[int64](time.Millisecond), Input: "-1.5s", ExpectedOutput: -1500},
+		"truncated":           MapTestParse[int]{Parse: parseDurationAs[int](time.Second), Input: "1999ms", ExpectedOutput: 1},
+		"rounded":             MapTestParse[int]{Parse: parseDurationAs[int](time.Second), Input: "1500ms", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundHalfAwayFromZero))}, ExpectedOutput: 2},
+		"float":               MapTestParse[float64]{Parse: parseDurationAs[float64](time.Second), Input: "1500ms", ExpectedOutput: 1.5},
+		"custom unit":         MapTestParse[uint8]{Parse: parseDurationAs[uint8](15 * time.Minute), Input: "1h", ExpectedOutput: 4},
+		"nanoseconds":         MapTestParse[int64]{Parse: parseDurationAs[int64](time.Nanosecond), Input: "2562047h47m16.854775807s", ExpectedOutput: math.MaxInt64},
+		"no decimal loss":     MapTestParse[int]{Parse: parseDurationAs[int](time.Millisecond), Input: "2s", ParseOptions: decimalLoss, ExpectedOutput: 2000},
+		"saturated":           MapTestParse[uint16]{Parse: parseDurationAs[uint16](time.Second), Input: "24h", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithSaturation())}, ExpectedOutput: math.MaxUint16},
+		"saturated with loss": MapTestParse[int8]{Parse: parseDurationAs[int8](time.Second), Input: "-200.5s", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithSaturation())}, ExpectedOutput: math.MinInt8},
+
+		"overflow": MapTestParse[int32]{
+			Parse:         parseDurationAs[int32](time.Millisecond),
+			Input:         "1000h",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 1000h (string) is greater than 2147483647 (int32)",
+		},
+		"overflow with fraction": MapTestParse[uint8]{Parse: parseDurationAs[uint8](time.Second), Input: "256.5s", ExpectedError: safecast.ErrExceedMaximumValue},
+		"negative to unsigned":   MapTestParse[uint32]{Parse: parseDurationAs[uint32](time.Second), Input: "-1s", ExpectedError: safecast.ErrExceedMinimumValue},
+		"decimal loss":           MapTestParse[int]{Parse: parseDurationAs[int](time.Second), Input: "1500ms", ParseOptions: decimalLoss, ExpectedError: safecast.ErrDecimalLoss},
+		"invalid": MapTestParse[int]{
+			Parse:         parseDurationAs[int](time.Second),
+			Input:         "1 hour",
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "conversion issue: cannot convert from `1 hour` to int",
+		},
+		"beyond time.Duration":               MapTestParse[int]{Parse: parseDurationAs[int](time.Hour), Input: "3000000h", ExpectedOutput: 3_000_000},
+		"beyond time.Duration negative":      MapTestParse[int64]{Parse: parseDurationAs[int64](time.Minute), Input: "-3000000h30m", ExpectedOutput: -180_000_030},
+		"beyond time.Duration with fraction": MapTestParse[int]{Parse: parseDurationAs[int](time.Hour), Input: "3000000.5h", ParseOptions: decimalLoss, ExpectedError: safecast.ErrDecimalLoss},
+		"beyond time.Duration rounded":       MapTestParse[int]{Parse: parseDurationAs[int](time.Hour), Input: "2999999h90m", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundHalfToEven))}, ExpectedOutput: 3_000_000},
+		"beyond time.Duration to float":      MapTestParse[float64]{Parse: parseDurationAs[float64](time.Nanosecond), Input: "1" + zeros(30) + "s", ExpectedOutput: 1e39},
+		"beyond time.Duration in micro":      MapTestParse[uint64]{Parse: parseDurationAs[uint64](time.Second), Input: "10000000000000000000000µs", ExpectedOutput: 10_000_000_000_000_000},
+		"beyond time.Duration overflow": MapTestParse[int32]{
+			Parse:         parseDurationAs[int32](time.Hour),
+			Input:         "3000000000h",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 3000000000h (string) is greater than 2147483647 (int32)",
+		},
+		"beyond time.Duration underflow": MapTestParse[uint8]{Parse: parseDurationAs[uint8](time.Hour), Input: "-3000000h", ExpectedError: safecast.ErrExceedMinimumValue},
+		"beyond all the types":           MapTestParse[float64]{Parse: parseDurationAs[float64](time.Hour), Input: "1" + zeros(1_000_000) + "ns", ExpectedError: safecast.ErrRangeOverflow},
+		"beyond time.Duration invalid":   MapTestParse[int]{Parse: parseDurationAs[int](time.Hour), Input: "3000000h1x", ExpectedError: safecast.ErrStringConversion},
+		"beyond time.Duration zero unit": MapTestParse[int]{Parse: parseDurationAs[int](0), Input: "3000000h", ExpectedError: safecast.ErrUnsupportedConversion},
+		"zero unit": MapTestParse[int]{
+			Parse:         parseDurationAs[int](0),
+			Input:         "1s",
+			ExpectedError: safecast.ErrUnsupportedConversion,
+			ErrorContains: "conversion issue: 0s (time.Duration) is not supported",
+		},
+		"negative unit": MapTestParse[int]{Parse: parseDurationAs[int](-time.Second), Input: "1s", ExpectedError: safecast.ErrUnsupportedConversion},
+		"with field": MapTestParse[int32]{
+			Parse:         parseDurationAs[int32](time.Second),
+			Input:         "invalid",
+			ParseOptions:  []safecast.ParseOption{safecast.WithParseField("timeout")},
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: `conversion issue: field "timeout": cannot convert from`,
+		},
+		"with field on range error": MapTestParse[int8]{
+			Parse:         parseDurationAs[int8](time.Second),
+			Input:         "200s",
+			ParseOptions:  []safecast.ParseOption{safecast.WithParseField("timeout")},
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: `conversion issue: field "timeout": 200s (string) is greater than 127 (int8)`,
+		},
+		"with field in convert options": MapTestParse[int8]{
+			Parse:         parseDurationAs[int8](time.Second),
+			Input:         "200s",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithField("timeout"))},
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: `conversion issue: field "timeout": 200s (string)`,
+		},
+		"with message formatter": MapTestParse[int]{
+			Parse: parseDurationAs[int](time.Second),
+			Input: "invalid",
+			ParseOptions: []safecast.ParseOption{safecast.WithParseMessageFormatter(func(e *safecast.ConversionError) string {
+				return "invalid duration " + e.Value.(string)
+			})},
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "invalid duration invalid",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleParseDurationAs() {
+	timeout, err := safecast.ParseDurationAs[int32]("1h30m", time.Millisecond)
+	fmt.Println(timeout, err)
+
+	_, err = safecast.ParseDurationAs[uint16]("250ms", time.Second, safecast.WithConvertOptions(safecast.WithDecimalLossReport()))
+	fmt.Println(err)
+
+	_, err = safecast.ParseDurationAs[uint16]("24h", time.Second)
+	fmt.Println(err)
+
+	// Output:
+	// 5400000 <nil>
+	// conversion issue: decimal loss during conversion
+	// conversion issue: 24h (string) is greater than 65535 (uint16): maximum value for this type exceeded
+}
+
+func TestDurationTo(t *testing.T) {
+	t.Run("within range", func(t *testing.T) {
+		ms, err := safecast.DurationTo[int32](90*time.Minute, time.Millisecond)
+		assertNoError(t, err)
+		assertEqual(t, int32(5_400_000), ms)
+
+		s, err := safecast.DurationTo[uint16](1500*time.Millisecond, time.Second)
+		assertNoError(t, err)
+		assertEqual(t, uint16(1), s)
+
+		f, err := safecast.DurationTo[float64](1500*time.Millisecond, time.Second)
+		assertNoError(t, err)
+		assertEqual(t, 1.5, f)
+
+		ns, err := safecast.DurationTo[int64](math.MinInt64, time.Nanosecond)
+		assertNoError(t, err)
+		assertEqual(t, int64(math.MinInt64), ns)
+
+		h, err := safecast.DurationTo[int8](-90*time.Minute, time.Hour, safecast.WithRounding(safecast.RoundFloor))
+		assertNoError(t, err)
+		assertEqual(t, int8(-2), h)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := safecast.DurationTo[int32](1000*time.Hour, time.Millisecond)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "conversion issue: 1000h0m0s (time.Duration) is greater than 2147483647 (int32)")
+
+		_, err = safecast.DurationTo[uint32](-time.Second, time.Second)
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+
+		_, err = safecast.DurationTo[uint8](256500*time.Millisecond, time.Second)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "conversion issue: 4m16.5s (time.Duration) is greater than 255 (uint8)")
+	})
+
+	t.Run("decimal loss", func(t *testing.T) {
+		_, err := safecast.DurationTo[int](1500*time.Millisecond, time.Second, safecast.WithDecimalLossReport())
+		requireErrorIs(t, err, safecast.ErrDecimalLoss)
+	})
+
+	t.Run("invalid unit", func(t *testing.T) {
+		_, err := safecast.DurationTo[int](time.Second, 0)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+	})
+}
+
+func TestDurationFrom(t *testing.T) {
+	d, err := safecast.DurationFrom(int32(1500), time.Millisecond)
+	assertNoError(t, err)
+	assertEqual(t, 1500*time.Millisecond, d)
+
+	d, err = safecast.DurationFrom(uint16(math.MaxUint16), time.Second)
+	assertNoError(t, err)
+	assertEqual(t, math.MaxUint16*time.Second, d)
+
+	d, err = safecast.DurationFrom(-1.5, time.Second)
+	assertNoError(t, err)
+	assertEqual(t, -1500*time.Millisecond, d)
+
+	d, err = safecast.DurationFrom(int64(-3), time.Hour)
+	assertNoError(t, err)
+	assertEqual(t, -3*time.Hour, d)
+
+	_, err = safecast.DurationFrom(uint64(math.MaxUint64), time.Nanosecond)
+	requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+
+	_, err = safecast.DurationFrom(int64(-300), 365*24*time.Hour)
+	requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+	requireErrorContains(t, err, "-300 (int64) * 8760h0m0s (time.Duration) is less than")
+
+	_, err = safecast.DurationFrom(1e10, time.Second)
+	requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+
+	_, err = safecast.DurationFrom(math.NaN(), time.Second)
+	requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+
+	_, err = safecast.DurationFrom(math.Inf(-1), time.Second)
+	requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+
+	_, err = safecast.DurationFrom(1e10, time.Second)
+	requireErrorContains(t, err, "conversion issue: 1e+10 (float64) * 1s (time.Duration) is greater than")
+}
+
+func TestDurationFrom_invalidUnit(t *testing.T) {
+	for name, unit := range map[string]time.Duration{
+		"zero":     0,
+		"negative": -time.Second,
+	} {
+		t.Run(name, func(t *testing.T) {
+			d, err := safecast.DurationFrom(5, unit)
+			requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+			assertEqual(t, time.Duration(0), d)
+
+			_, err = safecast.DurationFrom(1.5, unit)
+			requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		})
+	}
+
+	_, err := safecast.DurationFrom(5, -time.Second, safecast.WithField("timeout"))
+	requireErrorContains(t, err, `conversion issue: field "timeout": -1s (time.Duration) is not supported`)
+}
+
+func TestDurationFrom_withOptions(t *testing.T) {
+	d, err := safecast.DurationFrom(2.5, time.Nanosecond)
+	assertNoError(t, err)
+	assertEqual(t, time.Duration(2), d)
+
+	d, err = safecast.DurationFrom(2.5, time.Nanosecond, safecast.WithRounding(safecast.RoundHalfAwayFromZero))
+	assertNoError(t, err)
+	assertEqual(t, time.Duration(3), d)
+
+	d, err = safecast.DurationFrom(-0.25, 10*time.Nanosecond, safecast.WithRounding(safecast.RoundFloor))
+	assertNoError(t, err)
+	assertEqual(t, time.Duration(-3), d)
+
+	_, err = safecast.DurationFrom(2.5, time.Nanosecond, safecast.WithDecimalLossReport())
+	requireErrorIs(t, err, safecast.ErrDecimalLoss)
+
+	d, err = safecast.DurationFrom(0.3, time.Second, safecast.WithDecimalLossReport())
+	assertNoError(t, err)
+	assertEqual(t, 300*time.Millisecond, d)
+
+	d, err = safecast.DurationFrom(1.5, time.Second, safecast.WithDecimalLossReport())
+	assertNoError(t, err)
+	assertEqual(t, 1500*time.Millisecond, d)
+
+	d, err = safecast.DurationFrom(1e10, time.Second, safecast.WithSaturation())
+	assertNoError(t, err)
+	assertEqual(t, time.Duration(math.MaxInt64), d)
+
+	d, err = safecast.DurationFrom(int64(-300), 365*24*time.Hour, safecast.WithSaturation())
+	assertNoError(t, err)
+	assertEqual(t, time.Duration(math.MinInt64), d)
+}
+
+func TestUnixTo(t *testing.T) {
+	y2038 := time.Date(2038, time.January, 19, 3, 14, 8, 0, time.UTC)
+	beforeEpoch := time.Unix(-1, 500_000_000)
+
+	for name, tt := range map[string]struct {
+		t         time.Time
+		precision time.Duration
+		expected  int64
+	}{
+		"seconds":                   {t: y2038, precision: time.Second, expected: y2038.Unix()},
+		"milliseconds":              {t: y2038.Add(123456789), precision: time.Millisecond, expected: y2038.Add(123456789).UnixMilli()},
+		"microseconds":              {t: y2038.Add(123456789), precision: time.Microsecond, expected: y2038.Add(123456789).UnixMicro()},
+		"nanoseconds":               {t: y2038.Add(123456789), precision: time.Nanosecond, expected: y2038.Add(123456789).UnixNano()},
+		"seconds before epoch":      {t: beforeEpoch, precision: time.Second, expected: beforeEpoch.Unix()},
+		"milliseconds before epoch": {t: beforeEpoch.Add(1), precision: time.Millisecond, expected: beforeEpoch.Add(1).UnixMilli()},
+		"far future":                {t: time.Date(3000, time.January, 1, 0, 0, 0, 1, time.UTC), precision: time.Second, expected: time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()},
+		"far past":                  {t: time.Time{}, precision: time.Millisecond, expected: time.Time{}.UnixMilli()},
+		"far past with nanoseconds": {t: time.Time{}.Add(-1), precision: time.Second, expected: time.Time{}.Add(-1).Unix()},
+		"custom precision":          {t: time.Unix(3600*24, 0), precision: time.Hour, expected: 24},
+		"custom precision floored":  {t: time.Unix(-1, 0), precision: time.Hour, expected: -1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := safecast.UnixTo[int64](tt.t, tt.precision)
+			assertNoError(t, err)
+			assertEqual(t, tt.expected, got)
+		})
+	}
+
+	t.Run("year 2038", func(t *testing.T) {
+		got, err := safecast.UnixTo[int32](y2038.Add(-time.Second), time.Second)
+		assertNoError(t, err)
+		assertEqual(t, int32(math.MaxInt32), got)
+
+		_, err = safecast.UnixTo[int32](y2038, time.Second)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "conversion issue: 2038-01-19 03:14:08 +0000 UTC (time.Time) is greater than 2147483647 (int32)")
+
+		u, err := safecast.UnixTo[uint32](y2038, time.Second)
+		assertNoError(t, err)
+		assertEqual(t, uint32(math.MaxInt32+1), u)
+	})
+
+	t.Run("before epoch to unsigned", func(t *testing.T) {
+		_, err := safecast.UnixTo[uint32](beforeEpoch, time.Second)
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+	})
+
+	t.Run("far future overflow", func(t *testing.T) {
+		_, err := safecast.UnixTo[int64](time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC), time.Nanosecond)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "3000-01-01 00:00:00 +0000 UTC (time.Time) is greater than")
+	})
+
+	t.Run("options", func(t *testing.T) {
+		got, err := safecast.UnixTo[int](beforeEpoch, time.Second, safecast.WithRounding(safecast.RoundTruncate))
+		assertNoError(t, err)
+		assertEqual(t, 0, got)
+
+		_, err = safecast.UnixTo[int](beforeEpoch, time.Second, safecast.WithDecimalLossReport())
+		requireErrorIs(t, err, safecast.ErrDecimalLoss)
+
+		f, err := safecast.UnixTo[float64](beforeEpoch, time.Second)
+		assertNoError(t, err)
+		assertEqual(t, -0.5, f)
+	})
+
+	t.Run("invalid precision", func(t *testing.T) {
+		_, err := safecast.UnixTo[int64](time.Now(), 0)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+
+		_, err = safecast.UnixTo[int64](time.Time{}, -time.Second)
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+	})
+}
+
+func ExampleUnixTo() {
+	t := time.Date(2038, time.January, 19, 3, 14, 8, 0, time.UTC)
+
+	_, err := safecast.UnixTo[int32](t, time.Second)
+	fmt.Println(err)
+
+	ms, err := safecast.UnixTo[int64](t, time.Millisecond)
+	fmt.Println(ms, err)
+
+	// Output:
+	// conversion issue: 2038-01-19 03:14:08 +0000 UTC (time.Time) is greater than 2147483647 (int32): maximum value for this type exceeded
+	// 2147483648000 <nil>
+}
@@ -3,6 +3,8 @@ package safecast
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 )
 
 // ErrConversionIssue is a generic error for type conversion issues
@@ -55,51 +57,318 @@ var ErrStringConversion = errors.New("cannot convert from string")
 // [ErrConversionIssue] is also wrapped when this error is returned.
 var ErrDecimalLoss = errors.New("decimal loss during conversion")
 
-// errorHelper is a helper struct for error messages
-// It is used to wrap other errors, and provides additional information
-type errorHelper[NumOut Number] struct {
-	numberBase numberBase // base for number conversion, if applicable
-	value      any
-	err        error
+// ErrPrecisionLoss is an error for when a value cannot be represented exactly in the desired floating-point type.
+//
+// Examples include converting 1/3 as a [*big.Rat] to float64.
+//
+// [ErrConversionIssue] is also wrapped when this error is returned.
+var ErrPrecisionLoss = errors.New("precision loss during conversion")
+
+// ErrDivisionByZero is an error for when a division or a modulo by zero is attempted.
+//
+// Examples include dividing 42 by 0 with [Div].
+//
+// [ErrConversionIssue] is also wrapped when this error is returned.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// ConversionError is the error returned when a conversion fails.
+//
+// It can be retrieved with [errors.As] to get the details of the failure, instead of parsing the error message:
+//
+//	var convErr *ConversionError
+//	if errors.As(err, &convErr) {
+//		log.Printf("cannot convert %v from %s to %s", convErr.Value, convErr.From, convErr.To)
+//	}
+//
+// The sentinel errors, such as [ErrConversionIssue], [ErrRangeOverflow] or [ErrExceedMaximumValue],
+// are wrapped by ConversionError, so they can still be checked with [errors.Is].
+type ConversionError struct {
+	// Value is the value that failed to be converted, such as 1000 or "abc".
+	//
+	// It is nil when an arithmetic operation failed, such as with [Add].
+	Value any
+
+	// From is the name of the type of Value, such as "int" or "string".
+	From string
+
+	// To is the name of the desired type, such as "uint8".
+	To string
+
+	// Min and Max are the boundaries of the desired type, they are of the desired type.
+	Min, Max any
+
+	// Err is the sentinel error describing the failure, such as [ErrExceedMaximumValue] or [ErrStringConversion].
+	Err error
+
+	// Field is the path of the field that failed to be converted, such as "spec.replicas".
+	//
+	// It is empty unless [WithField], [WithParseField], or [WrapField] is used.
+	Field string
+
+	// Base is the number base used to parse Value when it is a string, 0 meaning the base was auto-detected.
+	//
+	// It is 10 for the other values.
+	Base int
+
+	// Reason explains why a string was rejected, such as "leading zero".
+	//
+	// It is empty unless a string was rejected by a rule of [Parse], such as the ones of [WithStrict].
+	Reason string
+
+	// Operation is the arithmetic operation that failed, such as "100 (int8) + 100 (int8)".
+	//
+	// It is empty unless an arithmetic operation failed, and it replaces Value in the error message.
+	Operation string
+
+	formatter MessageFormatter // formatter set with an option, it takes precedence over the package one
+}
+
+// newConversionError returns a [ConversionError] for a conversion of value to NumOut.
+func newConversionError[NumOut Number](value any, err error) *ConversionError {
+	e := &ConversionError{
+		Value: value,
+		To:    fmt.Sprintf("%T", NumOut(0)),
+		Min:   minOf[NumOut](),
+		Max:   maxOf[NumOut](),
+		Err:   err,
+		Base:  int(baseDecimal),
+	}
+	if value != nil {
+		e.From = fmt.Sprintf("%T", value)
+	}
+	return e
 }
 
-func (e errorHelper[NumOut]) Error() string {
+// Error returns the message of the error.
+//
+// The message is built by the [MessageFormatter] set with [WithMessageFormatter], [WithParseMessageFormatter],
+// or [SetMessageFormatter], and by [DefaultMessageFormatter] otherwise.
+func (e *ConversionError) Error() string {
+	if e.formatter != nil {
+		return e.formatter(e)
+	}
+	if formatter := messageFormatter.Load(); formatter != nil {
+		return (*formatter)(e)
+	}
+	return DefaultMessageFormatter(e)
+}
+
+func (e *ConversionError) Unwrap() []error {
+	errs := []error{ErrConversionIssue}
+	if e.Err != nil {
+		switch {
+		case
+			errors.Is(e.Err, ErrExceedMaximumValue),
+			errors.Is(e.Err, ErrExceedMinimumValue):
+			errs = append(errs, ErrRangeOverflow)
+		}
+		errs = append(errs, e.Err)
+	}
+	return errs
+}
+
+// MessageFormatter builds the message of a [ConversionError].
+//
+// It can be used to localize or reformat the messages, the sentinel errors wrapped by the [ConversionError]
+// are not affected by it.
+type MessageFormatter func(e *ConversionError) string
+
+// messageFormatter is the formatter set with [SetMessageFormatter].
+var messageFormatter atomic.Pointer[MessageFormatter]
+
+// SetMessageFormatter sets the [MessageFormatter] used by all the [ConversionError]s of the package,
+// unless another one is set with [WithMessageFormatter] or [WithParseMessageFormatter].
+//
+// Using nil restores [DefaultMessageFormatter].
+//
+// It is safe to call SetMessageFormatter concurrently with the conversions,
+// but it is expected to be called once, when the program starts.
+func SetMessageFormatter(formatter MessageFormatter) {
+	if formatter == nil {
+		messageFormatter.Store(nil)
+		return
+	}
+	messageFormatter.Store(&formatter)
+}
+
+// DefaultMessageFormatter is the [MessageFormatter] used by default.
+//
+// It can be called by a custom [MessageFormatter] to only change some messages.
+//
+// Example of message: conversion issue: 1000 (int) is greater than 255 (uint8): maximum value for this type exceeded
+func DefaultMessageFormatter(e *ConversionError) string {
 	errMessage := ErrConversionIssue.Error()
+	if e.Field != "" {
+		errMessage = fmt.Sprintf("%s: field %q", errMessage, e.Field)
+	}
+
+	value := e.Operation
+	if value == "" {
+		value = fmt.Sprintf("%v (%T)", e.Value, e.Value)
+	}
 
 	switch {
-	case errors.Is(e.err, ErrExceedMaximumValue):
-		boundary := maxOf[NumOut]()
-		errMessage = fmt.Sprintf("%s: %v (%T) is greater than %v (%T)", errMessage, e.value, e.value, boundary, boundary)
-	case errors.Is(e.err, ErrExceedMinimumValue):
-		boundary := minOf[NumOut]()
-		errMessage = fmt.Sprintf("%s: %v (%T) is less than %v (%T)", errMessage, e.value, e.value, boundary, boundary)
-	case errors.Is(e.err, ErrUnsupportedConversion):
-		errMessage = fmt.Sprintf("%s: %v (%T) is not supported", errMessage, e.value, e.value)
-	case errors.Is(e.err, ErrStringConversion):
-		baseInfoSuffix := e.numberBase.String()
+	case errors.Is(e.Err, ErrExceedMaximumValue):
+		errMessage = fmt.Sprintf("%s: %s is greater than %v (%T)", errMessage, value, e.Max, e.Max)
+	case errors.Is(e.Err, ErrExceedMinimumValue):
+		errMessage = fmt.Sprintf("%s: %s is less than %v (%T)", errMessage, value, e.Min, e.Min)
+	case errors.Is(e.Err, ErrUnsupportedConversion):
+		errMessage = fmt.Sprintf("%s: %s is not supported", errMessage, value)
+	case e.Operation != "":
+		errMessage = fmt.Sprintf("%s: %s", errMessage, e.Operation)
+	case errors.Is(e.Err, ErrStringConversion):
+		baseInfoSuffix := numberBase(e.Base).String()
 		if baseInfoSuffix != "" {
 			baseInfoSuffix = " (base " + baseInfoSuffix + ")"
 		}
-		targetType := NumOut(0)
-		return fmt.Sprintf("%s: cannot convert from %#q to %T%s", errMessage, e.value, targetType, baseInfoSuffix)
+		errMessage = fmt.Sprintf("%s: cannot convert from %#q to %s%s", errMessage, e.Value, e.To, baseInfoSuffix)
+		if e.Reason != "" {
+			errMessage = fmt.Sprintf("%s: %s", errMessage, e.Reason)
+		}
+		return errMessage
 	}
 
-	if e.err != nil {
-		errMessage = fmt.Sprintf("%s: %s", errMessage, e.err.Error())
+	if e.Err != nil {
+		errMessage = fmt.Sprintf("%s: %s", errMessage, e.Err.Error())
 	}
 	return errMessage
 }
 
-func (e errorHelper[NumOut]) Unwrap() []error {
-	errs := []error{ErrConversionIssue}
-	if e.err != nil {
-		switch {
-		case
-			errors.Is(e.err, ErrExceedMaximumValue),
-			errors.Is(e.err, ErrExceedMinimumValue):
-			errs = append(errs, ErrRangeOverflow)
+// WrapField records the name of a field in the conversion errors found in err.
+//
+// When an error already refers to a field, the name is prepended to its path, so the path can be built
+// while returning from nested structures (example: "replicas" wrapped with "spec" gives "spec.replicas").
+// Paths starting with an index, such as "[2]", are appended without a dot.
+//
+// The errors joined by this package, such as the ones returned by [ConvertSlice], are wrapped one by one.
+// The other errors, including the ones wrapping a [ConversionError] with [fmt.Errorf] or [errors.Join],
+// are kept as is and wrapped with the name of the field in their message.
+// The [ConversionError] they wrap, if any, still reports the field when retrieved with [errors.As].
+//
+// WrapField returns nil if err is nil.
+func WrapField(field string, err error) error {
+	return rewriteConversionErrors(err, func(e *ConversionError) {
+		e.Field = joinFieldPath(field, e.Field)
+	}, func(err error) error {
+		if e, ok := err.(*fieldError); ok {
+			return &fieldError{field: joinFieldPath(field, e.field), err: e.err}
 		}
-		errs = append(errs, e.err)
+		return &fieldError{field: field, err: err}
+	})
+}
+
+// fieldError is the error returned by [WrapField] for the errors that cannot be rewritten,
+// as they are not created by this package.
+type fieldError struct {
+	field string
+	err   error
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("field %q: %s", e.field, e.err)
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// As retrieves a copy of the [ConversionError] wrapped by the error, with the name of the field recorded in it.
+func (e *fieldError) As(target any) bool {
+	convErrTarget, ok := target.(**ConversionError)
+	if !ok {
+		return false
 	}
-	return errs
+
+	var convErr *ConversionError
+	if !errors.As(e.err, &convErr) {
+		return false
+	}
+
+	rewritten := *convErr
+	rewritten.Field = joinFieldPath(e.field, convErr.Field)
+	*convErrTarget = &rewritten
+	return true
+}
+
+// joinError is the counterpart of the error returned by [errors.Join] for the errors joined by this package,
+// so they can be told apart from the ones joined by the caller, which are not rewritten.
+type joinError struct {
+	errs []error
+}
+
+// joinErrors joins the errors the same way as [errors.Join].
+func joinErrors(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+	if len(joined) == 0 {
+		return nil
+	}
+	return &joinError{errs: joined}
+}
+
+func (e *joinError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *joinError) Unwrap() []error {
+	return e.errs
+}
+
+// rewriteConversionErrors calls rewrite on a copy of each [ConversionError] found in err,
+// including the ones joined by this package or wrapped by an [IndexError].
+//
+// The other errors are replaced by the result of other.
+func rewriteConversionErrors(err error, rewrite func(e *ConversionError), other func(err error) error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *ConversionError:
+		rewritten := *e
+		rewrite(&rewritten)
+		return &rewritten
+	case *IndexError:
+		return &IndexError{
+			Index: e.Index,
+			Err:   rewriteConversionErrors(e.Err, rewrite, other),
+		}
+	case *joinError:
+		rewritten := make([]error, 0, len(e.errs))
+		for _, err := range e.errs {
+			rewritten = append(rewritten, rewriteConversionErrors(err, rewrite, other))
+		}
+		return joinErrors(rewritten...)
+	}
+	return other(err)
+}
+
+// withValue replaces the value of the [ConversionError]s found in err.
+//
+// It is used when the converted value is computed from another one, so the errors refer to the one provided by the user.
+func withValue(value any, err error) error {
+	return rewriteConversionErrors(err, func(e *ConversionError) {
+		e.Value = value
+		e.From = fmt.Sprintf("%T", value)
+	}, func(err error) error {
+		return err
+	})
+}
+
+func joinFieldPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	}
+	return parent + "." + child
 }
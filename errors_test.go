@@ -0,0 +1,366 @@
+package safecast_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func requireConversionError(t *testing.T, err error) *safecast.ConversionError {
+	t.Helper()
+
+	var convErr *safecast.ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("error is not a ConversionError: %v", err)
+	}
+	return convErr
+}
+
+func TestConversionError(t *testing.T) {
+	t.Run("range error", func(t *testing.T) {
+		_, err := safecast.Convert[uint8](1000)
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, any(1000), convErr.Value)
+		assertEqual(t, "int", convErr.From)
+		assertEqual(t, "uint8", convErr.To)
+		assertEqual(t, any(uint8(0)), convErr.Min)
+		assertEqual(t, any(uint8(math.MaxUint8)), convErr.Max)
+		assertEqual(t, safecast.ErrExceedMaximumValue, convErr.Err)
+		assertEqual(t, err.Error(), convErr.Error())
+
+		requireErrorIs(t, convErr, safecast.ErrConversionIssue)
+		requireErrorIs(t, convErr, safecast.ErrRangeOverflow)
+	})
+
+	t.Run("float target", func(t *testing.T) {
+		_, err := safecast.Convert[float32](math.Inf(-1))
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, "float64", convErr.From)
+		assertEqual(t, "float32", convErr.To)
+		assertEqual(t, any(float32(-math.MaxFloat32)), convErr.Min)
+		assertEqual(t, any(float32(math.MaxFloat32)), convErr.Max)
+		assertEqual(t, safecast.ErrExceedMinimumValue, convErr.Err)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		_, err := safecast.Parse[int16]("abc")
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, any("abc"), convErr.Value)
+		assertEqual(t, "string", convErr.From)
+		assertEqual(t, "int16", convErr.To)
+		assertEqual(t, 10, convErr.Base)
+		assertEqual(t, safecast.ErrStringConversion, convErr.Err)
+
+		_, err = safecast.Parse[int16]("0xZZ", safecast.WithBaseAutoDetection())
+		assertEqual(t, 0, requireConversionError(t, err).Base)
+	})
+
+	t.Run("big number", func(t *testing.T) {
+		_, err := safecast.ConvertFromBigInt[int64](new(big.Int).Lsh(big.NewInt(1), 64))
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, "*big.Int", convErr.From)
+		assertEqual(t, "int64", convErr.To)
+		assertEqual(t, any(int64(math.MaxInt64)), convErr.Max)
+	})
+
+	t.Run("arithmetic operation", func(t *testing.T) {
+		_, err := safecast.Add[int8](100, 100)
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, nil, convErr.Value)
+		assertEqual(t, "", convErr.From)
+		assertEqual(t, "int8", convErr.To)
+		assertEqual(t, safecast.ErrExceedMaximumValue, convErr.Err)
+		requireErrorContains(t, convErr, "100 (int8) + 100 (int8) is greater than 127 (int8)")
+	})
+
+	t.Run("named type", func(t *testing.T) {
+		_, err := safecast.Convert[myInt](math.NaN())
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, "safecast_test.myInt", convErr.To)
+		assertEqual(t, safecast.ErrUnsupportedConversion, convErr.Err)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		_, err := safecast.ConvertSlice[uint8]([]int{1, -1})
+		convErr := requireConversionError(t, err)
+
+		assertEqual(t, any(-1), convErr.Value)
+		assertEqual(t, safecast.ErrExceedMinimumValue, convErr.Err)
+	})
+}
+
+func ExampleConversionError() {
+	_, err := safecast.Convert[uint8](1000)
+
+	var convErr *safecast.ConversionError
+	if errors.As(err, &convErr) {
+		fmt.Printf("%v (%s) is not within [%v, %v] (%s)\n", convErr.Value, convErr.From, convErr.Min, convErr.Max, convErr.To)
+		fmt.Println(errors.Is(convErr.Err, safecast.ErrExceedMaximumValue))
+	}
+
+	// Output:
+	// 1000 (int) is not within [0, 255] (uint8)
+	// true
+}
+
+func TestWithField(t *testing.T) {
+	t.Run("Convert", func(t *testing.T) {
+		_, err := safecast.Convert[uint8](1000, safecast.WithField("spec.replicas"))
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, `conversion issue: field "spec.replicas": 1000 (int) is greater than 255 (uint8)`)
+		assertEqual(t, "spec.replicas", requireConversionError(t, err).Field)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		out, err := safecast.Convert[uint8](42, safecast.WithField("spec.replicas"))
+		assertNoError(t, err)
+		assertEqual(t, uint8(42), out)
+	})
+
+	t.Run("with saturation", func(t *testing.T) {
+		out, err := safecast.Convert[uint8](1000, safecast.WithField("spec.replicas"), safecast.WithSaturation())
+		assertNoError(t, err)
+		assertEqual(t, uint8(math.MaxUint8), out)
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		_, err := safecast.Parse[uint8]("abc", safecast.WithParseField("spec.replicas"))
+		requireErrorIs(t, err, safecast.ErrStringConversion)
+		requireErrorContains(t, err, "conversion issue: field \"spec.replicas\": cannot convert from `abc` to uint8")
+
+		_, err = safecast.Parse[uint8]("-1", safecast.WithParseField("spec.replicas"))
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+		assertEqual(t, "spec.replicas", requireConversionError(t, err).Field)
+	})
+
+	t.Run("big number", func(t *testing.T) {
+		_, err := safecast.ConvertFromBigRat[int](big.NewRat(1, 3), safecast.WithField("ratio"), safecast.WithDecimalLossReport())
+		requireErrorIs(t, err, safecast.ErrDecimalLoss)
+		requireErrorContains(t, err, `conversion issue: field "ratio": decimal loss during conversion`)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		_, err := safecast.ConvertSlice[uint8]([]int{1, -1}, safecast.WithField("ports"))
+		requireErrorContains(t, err, `index 1: conversion issue: field "ports": -1 (int) is less than 0 (uint8)`)
+
+		var indexErr *safecast.IndexError
+		if !errors.As(err, &indexErr) {
+			t.Fatal("error is not an IndexError")
+		}
+		assertEqual(t, 1, indexErr.Index)
+	})
+}
+
+func TestWrapField(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assertNoError(t, safecast.WrapField("spec", nil))
+	})
+
+	t.Run("nested fields", func(t *testing.T) {
+		_, err := safecast.Convert[int8](200, safecast.WithField("replicas"))
+		err = safecast.WrapField("spec", err)
+
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, `conversion issue: field "spec.replicas": 200 (int) is greater than 127 (int8)`)
+		assertEqual(t, "spec.replicas", requireConversionError(t, err).Field)
+	})
+
+	t.Run("index", func(t *testing.T) {
+		_, err := safecast.Convert[int8](200, safecast.WithField("[2]"))
+		err = safecast.WrapField("containers", err)
+		err = safecast.WrapField("", err)
+		assertEqual(t, "containers[2]", requireConversionError(t, err).Field)
+	})
+
+	t.Run("original error is not modified", func(t *testing.T) {
+		_, err := safecast.Convert[int8](200)
+		wrapped := safecast.WrapField("replicas", err)
+
+		assertEqual(t, "", requireConversionError(t, err).Field)
+		assertEqual(t, "replicas", requireConversionError(t, wrapped).Field)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		var replicas, port int8
+		c := safecast.NewCollector()
+		c.Int8(&replicas, 200, "replicas")
+		c.Int8(&port, "abc", "port")
+		err := safecast.WrapField("spec", c.Err())
+
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorIs(t, err, safecast.ErrStringConversion)
+		requireErrorContains(t, err, `conversion issue: field "spec.replicas": 200 (int)`)
+		requireErrorContains(t, err, "conversion issue: field \"spec.port\": cannot convert from `abc`")
+	})
+
+	t.Run("errors joined by the caller", func(t *testing.T) {
+		_, err1 := safecast.Convert[int8](200)
+		_, err2 := safecast.Parse[int8]("abc")
+		joined := errors.Join(err1, err2)
+		err := safecast.WrapField("spec", joined)
+
+		requireErrorIs(t, err, joined)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorIs(t, err, safecast.ErrStringConversion)
+		assertEqual(t, `field "spec": `+joined.Error(), err.Error())
+		assertEqual(t, "spec", requireConversionError(t, err).Field)
+	})
+
+	t.Run("wrapped error", func(t *testing.T) {
+		_, convErr := safecast.Convert[int8](200, safecast.WithField("replicas"))
+		wrapped := fmt.Errorf("decoding: %w", convErr)
+		err := safecast.WrapField("spec", wrapped)
+		err = safecast.WrapField("root", err)
+
+		requireErrorIs(t, err, wrapped)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		assertEqual(t, `field "root.spec": decoding: conversion issue: field "replicas": 200 (int) is greater than 127 (int8): maximum value for this type exceeded`, err.Error())
+		assertEqual(t, "root.spec.replicas", requireConversionError(t, err).Field)
+		assertEqual(t, "replicas", requireConversionError(t, wrapped).Field)
+	})
+
+	t.Run("error with many wrapped errors", func(t *testing.T) {
+		_, err1 := safecast.Convert[int8](200)
+		_, err2 := safecast.Convert[uint8](-1)
+		wrapped := fmt.Errorf("a: %w; b: %w", err1, err2)
+		err := safecast.WrapField("spec", wrapped)
+
+		requireErrorIs(t, err, wrapped)
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+		assertEqual(t, `field "spec": `+wrapped.Error(), err.Error())
+		requireErrorContains(t, err, "a: conversion issue: 200 (int)")
+		requireErrorContains(t, err, "; b: conversion issue: -1 (int)")
+		assertEqual(t, "spec", requireConversionError(t, err).Field)
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		errOther := errors.New("other")
+		err := safecast.WrapField("spec", errOther)
+
+		requireErrorIs(t, err, errOther)
+		assertEqual(t, `field "spec": other`, err.Error())
+	})
+}
+
+func ExampleWrapField() {
+	type spec struct {
+		Replicas int64
+	}
+
+	s := spec{Replicas: 1 << 40}
+
+	_, err := safecast.Convert[int32](s.Replicas, safecast.WithField("replicas"))
+	err = safecast.WrapField("spec", err)
+	fmt.Println(err)
+
+	var convErr *safecast.ConversionError
+	if errors.As(err, &convErr) {
+		fmt.Println(convErr.Field)
+	}
+
+	// Output:
+	// conversion issue: field "spec.replicas": 1099511627776 (int64) is greater than 2147483647 (int32): maximum value for this type exceeded
+	// spec.replicas
+}
+
+// frenchFormatter is an example of localized messages.
+func frenchFormatter(e *safecast.ConversionError) string {
+	switch {
+	case errors.Is(e.Err, safecast.ErrExceedMaximumValue):
+		return fmt.Sprintf("%v (%s) est supérieur à %v (%s)", e.Value, e.From, e.Max, e.To)
+	case errors.Is(e.Err, safecast.ErrStringConversion):
+		return fmt.Sprintf("impossible de convertir %q en %s (base %d)", e.Value, e.To, e.Base)
+	}
+	return safecast.DefaultMessageFormatter(e)
+}
+
+func TestMessageFormatter(t *testing.T) {
+	t.Run("Convert", func(t *testing.T) {
+		_, err := safecast.Convert[uint8](1000, safecast.WithMessageFormatter(frenchFormatter))
+		assertEqual(t, "1000 (int) est supérieur à 255 (uint8)", err.Error())
+		requireErrorIs(t, err, safecast.ErrConversionIssue)
+		requireErrorIs(t, err, safecast.ErrRangeOverflow)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+	})
+
+	t.Run("fallback to the default formatter", func(t *testing.T) {
+		_, err := safecast.Convert[uint8](-1, safecast.WithMessageFormatter(frenchFormatter))
+		assertEqual(t, "conversion issue: -1 (int) is less than 0 (uint8): minimum value for this type exceeded", err.Error())
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		_, err := safecast.Parse[uint8]("zz", safecast.WithBaseHexadecimal(), safecast.WithParseMessageFormatter(frenchFormatter))
+		assertEqual(t, `impossible de convertir "zz" en uint8 (base 16)`, err.Error())
+		requireErrorIs(t, err, safecast.ErrStringConversion)
+
+		_, err = safecast.Parse[uint8]("1000", safecast.WithParseMessageFormatter(frenchFormatter))
+		assertEqual(t, "1000 (uint64) est supérieur à 255 (uint8)", err.Error())
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		_, err := safecast.ConvertSlice[uint8]([]int{1, 1000}, safecast.WithMessageFormatter(frenchFormatter))
+		assertEqual(t, "index 1: 1000 (int) est supérieur à 255 (uint8)", err.Error())
+	})
+
+	t.Run("with field", func(t *testing.T) {
+		formatter := func(e *safecast.ConversionError) string {
+			return e.Field + ": " + e.Err.Error()
+		}
+
+		_, err := safecast.Convert[uint8](1000, safecast.WithField("replicas"), safecast.WithMessageFormatter(formatter))
+		err = safecast.WrapField("spec", err)
+		assertEqual(t, "spec.replicas: maximum value for this type exceeded", err.Error())
+	})
+
+	t.Run("arithmetic operation", func(t *testing.T) {
+		_, err := safecast.Add[int8](100, 100)
+		convErr := requireConversionError(t, err)
+		assertEqual(t, "100 (int8) + 100 (int8)", convErr.Operation)
+		assertEqual(t, err.Error(), safecast.DefaultMessageFormatter(convErr))
+	})
+
+	t.Run("package formatter", func(t *testing.T) {
+		safecast.SetMessageFormatter(frenchFormatter)
+		t.Cleanup(func() {
+			safecast.SetMessageFormatter(nil)
+		})
+
+		_, err := safecast.Convert[int8](200)
+		assertEqual(t, "200 (int) est supérieur à 127 (int8)", err.Error())
+
+		// the formatter set with an option takes precedence
+		_, err = safecast.Convert[int8](200, safecast.WithMessageFormatter(safecast.DefaultMessageFormatter))
+		assertEqual(t, "conversion issue: 200 (int) is greater than 127 (int8): maximum value for this type exceeded", err.Error())
+
+		safecast.SetMessageFormatter(nil)
+		_, err = safecast.Convert[int8](200)
+		assertEqual(t, "conversion issue: 200 (int) is greater than 127 (int8): maximum value for this type exceeded", err.Error())
+	})
+}
+
+func ExampleWithMessageFormatter() {
+	german := func(e *safecast.ConversionError) string {
+		if errors.Is(e, safecast.ErrRangeOverflow) {
+			return fmt.Sprintf("%v liegt außerhalb des Bereichs von %s [%v, %v]", e.Value, e.To, e.Min, e.Max)
+		}
+		return safecast.DefaultMessageFormatter(e)
+	}
+
+	_, err := safecast.Convert[uint8](1000, safecast.WithMessageFormatter(german))
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, safecast.ErrExceedMaximumValue))
+
+	// Output:
+	// 1000 liegt außerhalb des Bereichs von uint8 [0, 255]
+	// true
+}
@@ -0,0 +1,96 @@
+package safecast
+
+import "strings"
+
+// WithLenient accepts the decorated numbers found in hand-written inputs or spreadsheet exports
+// when used with [Parse], such as " 42 " or "+7".
+//
+// The leading and trailing whitespace, and a leading "+" sign are ignored.
+// Use [WithAllowedPrefixes] and [WithAllowedSuffixes] to ignore other decorations, such as "$" or "items".
+//
+// The original string is still reported in the errors.
+//
+// Example:
+//
+//	value, err := Parse[uint8](" +7 ", WithLenient()) // 7, nil
+func WithLenient() ParseOption {
+	return func(pc *parseConfig) {
+		pc.lenient = true
+	}
+}
+
+// WithAllowedPrefixes ignores the prefixes when used with [Parse], such as "$" in "$1,200".
+//
+// The prefix can be surrounded by whitespace, and can follow the sign (example: "-$5" and "$-5").
+// Only one prefix is removed, the longest matching one. The prefixes are case-sensitive.
+//
+// It enables the lenient mode, see [WithLenient].
+//
+// Example:
+//
+//	value, err := Parse[int]("$1,200", WithAllowedPrefixes("$", "€"), WithThousandsSeparator(',')) // 1200, nil
+func WithAllowedPrefixes(prefixes ...string) ParseOption {
+	return func(pc *parseConfig) {
+		pc.lenient = true
+		pc.allowedPrefixes = append(pc.allowedPrefixes, prefixes...)
+	}
+}
+
+// WithAllowedSuffixes ignores the suffixes when used with [Parse], such as "items" in "42 items".
+//
+// The suffix can be preceded by whitespace.
+// Only one suffix is removed, the longest matching one. The suffixes are case-sensitive.
+//
+// It enables the lenient mode, see [WithLenient].
+//
+// Example:
+//
+//	value, err := Parse[int]("42 items", WithAllowedSuffixes("items", "item")) // 42, nil
+func WithAllowedSuffixes(suffixes ...string) ParseOption {
+	return func(pc *parseConfig) {
+		pc.lenient = true
+		pc.allowedSuffixes = append(pc.allowedSuffixes, suffixes...)
+	}
+}
+
+// trimDecorations removes the decorations ignored in lenient mode.
+func (pc *parseConfig) trimDecorations(s string) string {
+	s = strings.TrimSpace(s)
+
+	sign, s := cutSign(s)
+	if prefix, ok := longestMatch(pc.allowedPrefixes, s, strings.HasPrefix); ok {
+		s = strings.TrimSpace(s[len(prefix):])
+		if sign == "" {
+			// the sign can follow the prefix, such as in "$-5"
+			sign, s = cutSign(s)
+		}
+	}
+
+	if suffix, ok := longestMatch(pc.allowedSuffixes, s, strings.HasSuffix); ok {
+		s = strings.TrimSpace(s[:len(s)-len(suffix)])
+	}
+
+	// the "+" sign is only dropped when it is the only sign, so "++5" is still rejected
+	if sign == "-" || strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		return sign + s
+	}
+	return s
+}
+
+// cutSign removes the leading sign of s, and the whitespace following it.
+func cutSign(s string) (sign, rest string) {
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		return s[:1], strings.TrimSpace(s[1:])
+	}
+	return "", s
+}
+
+// longestMatch returns the longest non-empty candidate matching s.
+func longestMatch(candidates []string, s string, match func(s, candidate string) bool) (longest string, ok bool) {
+	for _, candidate := range candidates {
+		if len(candidate) > len(longest) && match(s, candidate) {
+			longest, ok = candidate, true
+		}
+	}
+	return longest, ok
+}
@@ -0,0 +1,86 @@
+package safecast_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParse_withLenient(t *testing.T) {
+	lenient := []safecast.ParseOption{safecast.WithLenient()}
+	currency := []safecast.ParseOption{safecast.WithAllowedPrefixes("$", "US$", "€"), safecast.WithThousandsSeparator(',')}
+	items := []safecast.ParseOption{safecast.WithAllowedSuffixes("item", "items", "%")}
+
+	for name, c := range map[string]TestRunner{
+		"whitespace":             MapTestParse[int]{Input: " 42 ", ParseOptions: lenient, ExpectedOutput: 42},
+		"tab and newline":        MapTestParse[int]{Input: "\t42\n", ParseOptions: lenient, ExpectedOutput: 42},
+		"plus sign":              MapTestParse[uint8]{Input: "+7", ParseOptions: lenient, ExpectedOutput: 7},
+		"plus sign and space":    MapTestParse[uint8]{Input: " + 7", ParseOptions: lenient, ExpectedOutput: 7},
+		"minus sign":             MapTestParse[int]{Input: " -7 ", ParseOptions: lenient, ExpectedOutput: -7},
+		"float":                  MapTestParse[float64]{Input: " +4.5 ", ParseOptions: lenient, ExpectedOutput: 4.5},
+		"prefix":                 MapTestParse[int]{Input: "$1,200", ParseOptions: currency, ExpectedOutput: 1200},
+		"longest prefix":         MapTestParse[int]{Input: "US$1,200", ParseOptions: currency, ExpectedOutput: 1200},
+		"prefix and space":       MapTestParse[int]{Input: " € 5 ", ParseOptions: currency, ExpectedOutput: 5},
+		"sign before prefix":     MapTestParse[int]{Input: "-$5", ParseOptions: currency, ExpectedOutput: -5},
+		"sign after prefix":      MapTestParse[int]{Input: "$-5", ParseOptions: currency, ExpectedOutput: -5},
+		"plus sign after prefix": MapTestParse[uint]{Input: "$+5", ParseOptions: currency, ExpectedOutput: 5},
+		"suffix":                 MapTestParse[int]{Input: "42 items", ParseOptions: items, ExpectedOutput: 42},
+		"suffix without space":   MapTestParse[int]{Input: "1item", ParseOptions: items, ExpectedOutput: 1},
+		"another suffix":         MapTestParse[float64]{Input: "12.5%", ParseOptions: items, ExpectedOutput: 12.5},
+		"prefix and suffix": MapTestParse[int]{
+			Input:          " $42 items ",
+			ParseOptions:   []safecast.ParseOption{safecast.WithAllowedPrefixes("$"), safecast.WithAllowedSuffixes("items")},
+			ExpectedOutput: 42,
+		},
+
+		"unknown prefix":      MapTestParse[int]{Input: "£5", ParseOptions: currency, ExpectedError: safecast.ErrStringConversion, ErrorContains: "`£5`"},
+		"unknown suffix":      MapTestParse[int]{Input: "42 boxes", ParseOptions: items, ExpectedError: safecast.ErrStringConversion},
+		"case-sensitive":      MapTestParse[int]{Input: "42 ITEMS", ParseOptions: items, ExpectedError: safecast.ErrStringConversion},
+		"only one prefix":     MapTestParse[int]{Input: "$$5", ParseOptions: currency, ExpectedError: safecast.ErrStringConversion},
+		"two signs":           MapTestParse[int]{Input: "++5", ParseOptions: lenient, ExpectedError: safecast.ErrStringConversion},
+		"two signs in prefix": MapTestParse[int]{Input: "-$-5", ParseOptions: currency, ExpectedError: safecast.ErrStringConversion},
+		"only decorations":    MapTestParse[int]{Input: "$ items", ParseOptions: append(currency, items...), ExpectedError: safecast.ErrStringConversion},
+		"negative to unsigned": MapTestParse[uint]{
+			Input:         " -5 ",
+			ParseOptions:  lenient,
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func TestParse_withoutLenient(t *testing.T) {
+	for name, c := range map[string]TestRunner{
+		"whitespace": MapTestParse[int]{Input: " 42 ", ExpectedError: safecast.ErrStringConversion},
+		"plus sign":  MapTestParse[uint8]{Input: "+7", ExpectedError: safecast.ErrStringConversion},
+		"prefix":     MapTestParse[int]{Input: "$5", ExpectedError: safecast.ErrStringConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleWithLenient() {
+	i, err := safecast.Parse[uint8](" +7 ", safecast.WithLenient())
+	fmt.Println(i, err)
+
+	i, err = safecast.Parse[uint8]("42 items", safecast.WithAllowedSuffixes("items"))
+	fmt.Println(i, err)
+
+	price, err := safecast.Parse[int]("$1,200", safecast.WithAllowedPrefixes("$"), safecast.WithThousandsSeparator(','))
+	fmt.Println(price, err)
+
+	_, err = safecast.Parse[uint8](" 42 boxes", safecast.WithAllowedSuffixes("items"))
+	fmt.Println(err)
+
+	// Output:
+	// 7 <nil>
+	// 42 <nil>
+	// 1200 <nil>
+	// conversion issue: cannot convert from ` 42 boxes` to uint8
+}
@@ -0,0 +1,155 @@
+package safecast
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Locale defines the separators used to format numbers, such as "1,234.5" or "1.234,5".
+//
+// Use it with [WithLocale], or use one of the provided presets such as [LocaleEnglish].
+type Locale struct {
+	// ThousandsSeparator separates the groups of three digits of the integer part, such as ',' in "1,234".
+	// Zero means the digits are not grouped.
+	ThousandsSeparator rune
+
+	// DecimalSeparator separates the integer part from the fractional part, such as ',' in "1,5".
+	// Zero means '.' is used.
+	DecimalSeparator rune
+}
+
+var (
+	// LocaleEnglish is the [Locale] for numbers such as "1,234,567.89".
+	LocaleEnglish = Locale{ThousandsSeparator: ',', DecimalSeparator: '.'}
+
+	// LocaleGerman is the [Locale] for numbers such as "1.234.567,89".
+	//
+	// It is also used by many other European countries, such as Italy, Spain, or the Netherlands.
+	LocaleGerman = Locale{ThousandsSeparator: '.', DecimalSeparator: ','}
+
+	// LocaleFrench is the [Locale] for numbers such as "1 234 567,89".
+	//
+	// The non-breaking spaces usually found in French formatting are accepted too, see [WithThousandsSeparator].
+	LocaleFrench = Locale{ThousandsSeparator: ' ', DecimalSeparator: ','}
+
+	// LocaleSwiss is the [Locale] for numbers such as "1'234'567.89".
+	LocaleSwiss = Locale{ThousandsSeparator: '\'', DecimalSeparator: '.'}
+)
+
+// WithLocale sets the separators defined by locale when used with [Parse].
+//
+// See [WithThousandsSeparator] and [WithDecimalSeparator] for the details.
+//
+// Example:
+//
+//	value, err := Parse[float64]("1.234.567,89", WithLocale(LocaleGerman))
+func WithLocale(locale Locale) ParseOption {
+	return func(pc *parseConfig) {
+		pc.thousandsSeparator = locale.ThousandsSeparator
+		pc.decimalSeparator = locale.DecimalSeparator
+	}
+}
+
+// WithThousandsSeparator sets the separator of the groups of digits when used with [Parse], such as ',' for "1,234,567".
+//
+// The separator is only accepted in the integer part, and it must separate groups of three digits,
+// the first group having one to three digits. The digits can also be left ungrouped, such as "1234567".
+//
+// When the separator is a space, the non-breaking spaces U+00A0 and U+202F are accepted too.
+//
+// When the separator is also the decimal separator, such as '.' without [WithDecimalSeparator],
+// it is only used as the thousands separator, and the numbers cannot have a fractional part:
+// "1.234" is 1234, and "1.5" is rejected.
+//
+// Example:
+//
+//	value, err := Parse[int32]("1,234,567", WithThousandsSeparator(','))
+func WithThousandsSeparator(separator rune) ParseOption {
+	return func(pc *parseConfig) {
+		pc.thousandsSeparator = separator
+	}
+}
+
+// WithDecimalSeparator sets the separator of the fractional part when used with [Parse], such as ',' for "1,5".
+//
+// When another separator than '.' is used, '.' is only accepted as the thousands separator.
+//
+// Example:
+//
+//	value, err := Parse[float64]("1,5", WithDecimalSeparator(','))
+func WithDecimalSeparator(separator rune) ParseOption {
+	return func(pc *parseConfig) {
+		pc.decimalSeparator = separator
+	}
+}
+
+// normalizeSeparators rewrites s with the Go syntax expected by [strconv], according to the separators.
+//
+// It reports false if the separators are misplaced, such as in "12,34" with ',' as the thousands separator.
+func (pc *parseConfig) normalizeSeparators(s string) (string, bool) {
+	decimalSeparator := pc.decimalSeparator
+	if decimalSeparator == 0 {
+		decimalSeparator = '.'
+	}
+
+	if pc.thousandsSeparator == 0 && decimalSeparator == '.' {
+		return s, true
+	}
+
+	if decimalSeparator != '.' && pc.thousandsSeparator != '.' && strings.Contains(s, ".") {
+		// "1.5" must not be parsed as 1.5 when the decimal separator is ','
+		return "", false
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(s, string(decimalSeparator))
+	if decimalSeparator == pc.thousandsSeparator {
+		// the separator is ambiguous, so it is only the thousands separator: "1.234" is 1234, not 1.234
+		integer, fraction, hasFraction = s, "", false
+	}
+
+	if pc.thousandsSeparator != 0 {
+		if strings.IndexFunc(integer, pc.isThousandsSeparator) >= 0 {
+			groups := strings.FieldsFunc(integer, pc.isThousandsSeparator)
+			if !isValidGrouping(integer, groups) {
+				return "", false
+			}
+			integer = strings.Join(groups, "")
+		}
+	}
+
+	if !hasFraction {
+		return sign + integer, true
+	}
+	return sign + integer + "." + fraction, true
+}
+
+func (pc *parseConfig) isThousandsSeparator(r rune) bool {
+	if pc.thousandsSeparator == ' ' {
+		return r == ' ' || r == '\u00a0' || r == '\u202f'
+	}
+	return r == pc.thousandsSeparator
+}
+
+// isValidGrouping reports whether groups are groups of three digits, the first one having one to three digits,
+// and whether they are separated by exactly one separator in integer.
+func isValidGrouping(integer string, groups []string) bool {
+	// the separators at the start or the end, or repeated ones, are removed by strings.FieldsFunc,
+	// so the number of runes is checked to detect them
+	separators := utf8.RuneCountInString(integer) - utf8.RuneCountInString(strings.Join(groups, ""))
+	if separators != len(groups)-1 {
+		return false
+	}
+
+	for i, group := range groups {
+		n := utf8.RuneCountInString(group)
+		if n > 3 || i > 0 && n != 3 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParse_withLocale(t *testing.T) {
+	english := []safecast.ParseOption{safecast.WithLocale(safecast.LocaleEnglish)}
+	german := []safecast.ParseOption{safecast.WithLocale(safecast.LocaleGerman)}
+	french := []safecast.ParseOption{safecast.WithLocale(safecast.LocaleFrench)}
+	swiss := []safecast.ParseOption{safecast.WithLocale(safecast.LocaleSwiss)}
+	dots := []safecast.ParseOption{safecast.WithThousandsSeparator('.')}
+
+	for name, c := range map[string]TestRunner{
+		"english integer":          MapTestParse[int32]{Input: "1,234,567", ParseOptions: english, ExpectedOutput: 1234567},
+		"english negative":         MapTestParse[int32]{Input: "-1,234", ParseOptions: english, ExpectedOutput: -1234},
+		"english float":            MapTestParse[float64]{Input: "1,234,567.89", ParseOptions: english, ExpectedOutput: 1234567.89},
+		"english ungrouped":        MapTestParse[uint32]{Input: "1234567", ParseOptions: english, ExpectedOutput: 1234567},
+		"english small":            MapTestParse[uint8]{Input: "12", ParseOptions: english, ExpectedOutput: 12},
+		"german integer":           MapTestParse[int64]{Input: "1.234.567", ParseOptions: german, ExpectedOutput: 1234567},
+		"german float":             MapTestParse[float64]{Input: "1.234.567,89", ParseOptions: german, ExpectedOutput: 1234567.89},
+		"german decimal":           MapTestParse[float32]{Input: "-0,5", ParseOptions: german, ExpectedOutput: -0.5},
+		"french float":             MapTestParse[float64]{Input: "1 234,5", ParseOptions: french, ExpectedOutput: 1234.5},
+		"french non-breaking":      MapTestParse[int]{Input: "1\u202f234\u00a0567", ParseOptions: french, ExpectedOutput: 1234567},
+		"swiss":                    MapTestParse[float64]{Input: "1'234.5", ParseOptions: swiss, ExpectedOutput: 1234.5},
+		"truncated":                MapTestParse[int]{Input: "1.234,9", ParseOptions: german, ExpectedOutput: 1234},
+		"only thousands separator": MapTestParse[uint16]{Input: "65,535", ParseOptions: []safecast.ParseOption{safecast.WithThousandsSeparator(',')}, ExpectedOutput: math.MaxUint16},
+		"only decimal separator":   MapTestParse[float64]{Input: "1234,5", ParseOptions: []safecast.ParseOption{safecast.WithDecimalSeparator(',')}, ExpectedOutput: 1234.5},
+		"unicode separator":        MapTestParse[int]{Input: "1·234", ParseOptions: []safecast.ParseOption{safecast.WithThousandsSeparator('·')}, ExpectedOutput: 1234},
+		"options override locale": MapTestParse[float64]{
+			Input:          "1_234,5",
+			ParseOptions:   []safecast.ParseOption{safecast.WithLocale(safecast.LocaleFrench), safecast.WithThousandsSeparator('_')},
+			ExpectedOutput: 1234.5,
+		},
+		"dot as thousands separator only":  MapTestParse[int]{Input: "1.234", ParseOptions: dots, ExpectedOutput: 1234},
+		"dot as thousands separator float": MapTestParse[float64]{Input: "1.234.567", ParseOptions: dots, ExpectedOutput: 1234567},
+		"same separators":                  MapTestParse[int]{Input: "1,234", ParseOptions: []safecast.ParseOption{safecast.WithLocale(safecast.Locale{ThousandsSeparator: ',', DecimalSeparator: ','})}, ExpectedOutput: 1234},
+		"no fractional part with same separators": MapTestParse[float64]{
+			Input:         "1.5",
+			ParseOptions:  dots,
+			ExpectedError: safecast.ErrStringConversion,
+		},
+		"no fractional part after groups with same separators": MapTestParse[float64]{
+			Input:         "1.234.5",
+			ParseOptions:  dots,
+			ExpectedError: safecast.ErrStringConversion,
+		},
+
+		"overflow": MapTestParse[uint16]{
+			Input:         "65,536",
+			ParseOptions:  english,
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "65536 (uint64) is greater than 65535 (uint16)",
+		},
+		"underflow": MapTestParse[int8]{Input: "-1.000", ParseOptions: german, ExpectedError: safecast.ErrExceedMinimumValue},
+		"decimal loss": MapTestParse[int]{
+			Input:         "1.234,5",
+			ParseOptions:  append(german, safecast.WithConvertOptions(safecast.WithDecimalLossReport())),
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"invalid group size": MapTestParse[int]{
+			Input:         "12,34",
+			ParseOptions:  english,
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "cannot convert from `12,34` to int",
+		},
+		"first group too long":   MapTestParse[int]{Input: "1234,567", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"leading separator":      MapTestParse[int]{Input: ",123", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"trailing separator":     MapTestParse[int]{Input: "123,", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"repeated separator":     MapTestParse[int]{Input: "1,,234", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"separator in fraction":  MapTestParse[float64]{Input: "1.234,567", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"dot with comma decimal": MapTestParse[float64]{Input: "1.5", ParseOptions: french, ExpectedError: safecast.ErrStringConversion, ErrorContains: "cannot convert from `1.5` to float64"},
+		"two decimal separators": MapTestParse[float64]{Input: "1,2,3", ParseOptions: german, ExpectedError: safecast.ErrStringConversion},
+		"sign after separator":   MapTestParse[int]{Input: "1,-234", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+		"not a number":           MapTestParse[int]{Input: "abc", ParseOptions: english, ExpectedError: safecast.ErrStringConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleWithLocale() {
+	for _, s := range []string{"1.234.567", "1.234,5", "12.34"} {
+		v, err := safecast.Parse[int32](s, safecast.WithLocale(safecast.LocaleGerman))
+		fmt.Println(v, err)
+	}
+
+	// Output:
+	// 1234567 <nil>
+	// 1234 <nil>
+	// 0 conversion issue: cannot convert from `12.34` to int32
+}
@@ -12,6 +12,10 @@ import (
 //
 // Parse is a convenient wrapper around [strconv.ParseInt], [strconv.ParseUint], and [strconv.ParseFloat].
 //
+// Decimal numbers with a fractional part or an exponent, such as "1.5" or "1e3", are parsed exactly
+// when converted to an integer type, so the range and the decimal loss are checked on the exact value
+// and not on a float64 approximation of it (example: "18446744073709551615.0" to uint64).
+//
 // # Behavior
 //
 // If the conversion is possible, the converted value is returned.
@@ -33,15 +37,109 @@ import (
 //
 // Use one of the provided option functions to set the desired behavior.
 // See [WithBaseDecimal], [WithBaseHexadecimal], [WithBaseOctal], [WithBaseBinary], and [WithBaseAutoDetection].
+// Other bases can be set with [WithBase] and [WithAlphabet].
+// The prefixes such as "0x" can be accepted with [WithOptionalBasePrefix] and [WithBasePrefixDetection].
+//
+// Localized numbers such as "1,234,567" or "1.234,5" can be parsed with [WithLocale],
+// [WithThousandsSeparator], and [WithDecimalSeparator].
+//
+// Only the canonical forms of the numbers are accepted with [WithStrict],
+// while the decorations such as whitespace or currency symbols are ignored with [WithLenient].
 func Parse[NumOut Number](s string, opts ...ParseOption) (converted NumOut, err error) {
+	return parseWith(s, opts, parse[NumOut])
+}
+
+// parseWith parses s with the parser once the options are parsed,
+// and applies the options changing how the errors are reported.
+func parseWith[NumOut Number](s string, opts []ParseOption, parser func(string, *parseConfig) (NumOut, error)) (NumOut, error) {
 	options := newParseOptions(opts...)
+
+	converted, err := parser(s, options)
+	if err == nil {
+		return converted, nil
+	}
+
+	if options.field != "" {
+		err = WrapField(options.field, err)
+	}
+	if options.formatter != nil {
+		err = withMessageFormatter(options.formatter, err)
+	}
+	return converted, err
+}
+
+func parse[NumOut Number](orig string, options *parseConfig) (NumOut, error) {
 	numberBase := options.numberBase
 
+	// the original string is reported in the errors, not the normalized one
+	s := orig
+	if options.lenient {
+		s = options.trimDecorations(s)
+	}
+
+	if options.alphabet != "" {
+		return parseAlphabet[NumOut](orig, s, options)
+	}
+
+	if !numberBase.isValid() {
+		e := newConversionError[NumOut](orig, ErrStringConversion)
+		e.Reason = "invalid base"
+		return 0, e
+	}
+
+	s, ok := options.normalizeSeparators(s)
+	if !ok {
+		e := newConversionError[NumOut](orig, ErrStringConversion)
+		e.Base = int(numberBase)
+		return 0, e
+	}
+
+	if options.strict {
+		if reason := options.strictViolation(orig, s); reason != "" {
+			e := newConversionError[NumOut](orig, ErrStringConversion)
+			e.Base = int(numberBase)
+			e.Reason = reason
+			return 0, e
+		}
+	}
+
+	s = options.normalizeBasePrefix(s)
+
 	// naive auto-detection of the sign
 	isNegative := strings.HasPrefix(s, "-")
 
+	// the exponents are only supported in decimal, "1e3" with WithBaseHexadecimal is 0x1e3
+	hasExponent := numberBase == baseDecimal || numberBase == baseAuto
+
+	// the numbers with a fractional part are parsed as decimal numbers with the bases that always accepted them,
+	// so "1.5" is 1.5 with WithBaseHexadecimal, but it is rejected with the other bases set with WithBase
+	hasFraction := hasExponent || numberBase == baseHexadecimal || numberBase == baseOctal || numberBase == baseBinary
+
+	// decimal numbers with a fractional part or an exponent, such as "1.5" or "1e3"
+	var (
+		decimal   decimalLiteral
+		isDecimal bool
+	)
+	if hasExponent && strings.ContainsAny(s, ".eE") {
+		decimal, isDecimal = parseDecimalLiteral(s)
+	}
+
+	// the range and the decimal loss are checked on the exact value, not on a float64 approximation of it
+	if isDecimal && !isFloat[NumOut]() {
+		converted, err := ConvertFromBigRat[NumOut](decimal.rat(), options.convertOptions...)
+		if err == nil {
+			return converted, nil
+		}
+
+		// the errors report the parsed float64, as for the other decimal numbers, such as "-1.1 (float64)",
+		// the values beyond the range of float64 are reported below as they are for the floating-point types
+		if f, errFloat := strconv.ParseFloat(s, 64); errFloat == nil {
+			return converted, withValue(f, err)
+		}
+	}
+
 	// naive auto-detection of float
-	if strings.Contains(s, ".") {
+	if isDecimal || hasFraction && strings.Contains(s, ".") {
 		o, err := strconv.ParseFloat(s, 64)
 		if err != nil {
 			errParseFloat := ErrStringConversion
@@ -52,14 +150,12 @@ func Parse[NumOut Number](s string, opts ...ParseOption) (converted NumOut, err
 				}
 			}
 
-			// If the error is a range error, wrap it in an errorHelper
-			return 0, errorHelper[NumOut]{
-				numberBase: numberBase,
-				value:      s,
-				err:        errParseFloat,
-			}
+			// If the error is a range error, wrap it in a ConversionError
+			e := newConversionError[NumOut](orig, errParseFloat)
+			e.Base = int(numberBase)
+			return 0, e
 		}
-		return Convert[NumOut](o)
+		return Convert[NumOut](o, options.convertOptions...)
 	}
 
 	if isNegative {
@@ -72,14 +168,12 @@ func Parse[NumOut Number](s string, opts ...ParseOption) (converted NumOut, err
 					errParseInt = ErrExceedMinimumValue
 				}
 			}
-			return 0, errorHelper[NumOut]{
-				numberBase: numberBase,
-				value:      s,
-				err:        errParseInt,
-			}
+			e := newConversionError[NumOut](orig, errParseInt)
+			e.Base = int(numberBase)
+			return 0, e
 		}
 
-		return Convert[NumOut](o)
+		return Convert[NumOut](o, options.convertOptions...)
 	}
 
 	o, err := strconv.ParseUint(s, int(options.numberBase), 64)
@@ -88,13 +182,11 @@ func Parse[NumOut Number](s string, opts ...ParseOption) (converted NumOut, err
 		if errors.Is(err, strconv.ErrRange) {
 			errParseUint = ErrExceedMaximumValue
 		}
-		return 0, errorHelper[NumOut]{
-			numberBase: numberBase,
-			value:      s,
-			err:        errParseUint,
-		}
+		e := newConversionError[NumOut](orig, errParseUint)
+		e.Base = int(numberBase)
+		return 0, e
 	}
-	return Convert[NumOut](o)
+	return Convert[NumOut](o, options.convertOptions...)
 }
 
 // MustParse calls [Parse] to convert the value to the desired type, and panics if the conversion fails.
@@ -143,6 +235,7 @@ const (
 	baseDecimal     numberBase = 10
 	baseOctal       numberBase = 8
 	baseBinary      numberBase = 2
+	baseInvalid     numberBase = -1
 )
 
 func (nb numberBase) String() string {
@@ -155,13 +248,74 @@ func (nb numberBase) String() string {
 		return "octal"
 	case baseBinary:
 		return "binary"
-	default:
+	case baseDecimal:
 		return "" // decimal is the default, so we return empty string
+	default:
+		return strconv.Itoa(int(nb))
 	}
 }
 
+// isValid reports whether the base is supported by [strconv.ParseInt] and [strconv.ParseUint].
+func (nb numberBase) isValid() bool {
+	return nb == baseAuto || nb >= minBase && nb <= maxBase
+}
+
+const (
+	minBase numberBase = 2
+	maxBase numberBase = 36
+)
+
 type parseConfig struct {
-	numberBase numberBase
+	numberBase           numberBase
+	thousandsSeparator   rune
+	decimalSeparator     rune
+	caseInsensitiveUnits bool
+	strict               bool
+	lenient              bool
+	allowedPrefixes      []string
+	allowedSuffixes      []string
+	alphabet             string
+	optionalBasePrefix   bool
+	prefixDetection      bool
+	field                string
+	formatter            MessageFormatter
+	convertOptions       []ConvertOption
+}
+
+// WithParseField records the name of the field being parsed in the returned error when used with [Parse].
+//
+// It is the counterpart of [WithField] for [Parse].
+//
+// Example:
+//
+//	value, err := Parse[uint8]("abc", WithParseField("spec.replicas"))
+//	// conversion issue: field "spec.replicas": cannot convert from `abc` to uint8
+func WithParseField(name string) ParseOption {
+	return func(pc *parseConfig) {
+		pc.field = name
+	}
+}
+
+// WithParseMessageFormatter sets the [MessageFormatter] used to build the messages of the errors
+// returned by [Parse].
+//
+// It is the counterpart of [WithMessageFormatter] for [Parse].
+func WithParseMessageFormatter(formatter MessageFormatter) ParseOption {
+	return func(pc *parseConfig) {
+		pc.formatter = formatter
+	}
+}
+
+// WithConvertOptions sets the [ConvertOption]s used to convert the parsed value to the desired type
+// when used with [Parse].
+//
+// Example:
+//
+//	value, err := Parse[uint8]("1000", WithConvertOptions(WithSaturation())) // 255, nil
+func WithConvertOptions(opts ...ConvertOption) ParseOption {
+	return func(pc *parseConfig) {
+		pc.convertOptions = append(pc.convertOptions, opts...)
+	}
 }
 
 // WithBaseDecimal sets the number base to decimal (base 10) when used with [Parse].
@@ -175,7 +329,7 @@ func WithBaseDecimal() ParseOption {
 
 // WithBaseHexadecimal sets the number base to hexadecimal (base 16) when used with [Parse].
 //
-// Note that the string to parse must not have the "0x" prefix; use [WithBaseAutoDetection] for that.
+// Note that the string to parse must not have the "0x" prefix, unless [WithOptionalBasePrefix] is used.
 func WithBaseHexadecimal() ParseOption {
 	return func(pc *parseConfig) {
 		pc.numberBase = baseHexadecimal
@@ -184,7 +338,7 @@ func WithBaseHexadecimal() ParseOption {
 
 // WithBaseOctal sets the number base to octal (base 8) when used with [Parse].
 //
-// Note that the string to parse must not have the "0o" prefix; use [WithBaseAutoDetection] for that.
+// Note that the string to parse must not have the "0o" prefix, unless [WithOptionalBasePrefix] is used.
 func WithBaseOctal() ParseOption {
 	return func(pc *parseConfig) {
 		pc.numberBase = baseOctal
@@ -193,13 +347,37 @@ func WithBaseOctal() ParseOption {
 
 // WithBaseBinary sets the number base to binary (base 2) when used with [Parse].
 //
-// Note that the string to parse must not have the "0b" prefix; use [WithBaseAutoDetection] for that.
+// Note that the string to parse must not have the "0b" prefix, unless [WithOptionalBasePrefix] is used.
 func WithBaseBinary() ParseOption {
 	return func(pc *parseConfig) {
 		pc.numberBase = baseBinary
 	}
 }
 
+// WithBase sets the number base to any base from 2 to 36 when used with [Parse], such as 36 for "zik0zj".
+//
+// The digits greater than 9 are the letters of the Latin alphabet, they are case-insensitive.
+// Use [WithAlphabet] for other digits.
+//
+// [Parse] fails with [ErrStringConversion] when the base is not in this range.
+//
+// The numbers with a fractional part, such as "1.2", are rejected. The bases 2, 8, 10, and 16 are the exceptions,
+// as they are the same as [WithBaseBinary], [WithBaseOctal], [WithBaseDecimal], and [WithBaseHexadecimal]:
+// such numbers are parsed as decimal numbers with them, so "1.5" is 1.5.
+//
+// Example:
+//
+//	value, err := Parse[uint64]("zik0zj", WithBase(36)) // 2147483647, nil
+func WithBase(base int) ParseOption {
+	return func(pc *parseConfig) {
+		pc.numberBase = numberBase(base)
+		if pc.numberBase == baseAuto {
+			// 0 is reserved to the auto-detection, use WithBaseAutoDetection for it
+			pc.numberBase = baseInvalid
+		}
+	}
+}
+
 // WithBaseAutoDetection sets the number base to auto-detection when used with [Parse].
 //
 // The base is implied by the string's prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
@@ -215,9 +393,105 @@ func WithBaseBinary() ParseOption {
 //	 - "010" is interpreted as 8 in decimal, not 10.
 //	 - "07" is interpreted as 7 in decimal, but "08" or "09" will fail to parse because 8 and 9 are not valid octal digits.
 //
+//	Use [WithBasePrefixDetection] to avoid it.
+//
 // [integer literals]: https://go.dev/ref/spec#Integer_literals
 func WithBaseAutoDetection() ParseOption {
 	return func(pc *parseConfig) {
 		pc.numberBase = baseAuto
+		pc.prefixDetection = false
+	}
+}
+
+// WithBasePrefixDetection sets the number base to auto-detection when used with [Parse],
+// without the legacy octal form of the Go [integer literals].
+//
+// The base is implied by the string's prefix following the sign (if present): 2 for "0b", 8 for "0o",
+// 16 for "0x", and 10 otherwise. Unlike [WithBaseAutoDetection], a leading "0" doesn't imply the octal base,
+// so "010" is 10 and "08" is 8.
+//
+// The underscore characters are permitted as with [WithBaseAutoDetection].
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func WithBasePrefixDetection() ParseOption {
+	return func(pc *parseConfig) {
+		pc.numberBase = baseAuto
+		pc.prefixDetection = true
+	}
+}
+
+// WithOptionalBasePrefix accepts the prefix of the number base when used with [Parse]
+// with [WithBaseHexadecimal], [WithBaseOctal], or [WithBaseBinary].
+//
+// The prefix is optional, and follows the sign (if present): "0x" or "0X" for hexadecimal, "0o" or "0O" for octal,
+// and "0b" or "0B" for binary. The prefixes of the other bases are still rejected,
+// so "0b1" is 0xB1 with [WithBaseHexadecimal], and it is rejected with [WithBaseOctal].
+//
+// Example:
+//
+//	value, err := Parse[uint8]("0xFF", WithBaseHexadecimal(), WithOptionalBasePrefix()) // 255, nil
+//	value, err = Parse[uint8]("FF", WithBaseHexadecimal(), WithOptionalBasePrefix())    // 255, nil
+func WithOptionalBasePrefix() ParseOption {
+	return func(pc *parseConfig) {
+		pc.optionalBasePrefix = true
+	}
+}
+
+// basePrefixes are the prefixes of the number bases, as defined by the Go [integer literals].
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+var basePrefixes = map[numberBase][]string{
+	baseBinary:      {"0b", "0B"},
+	baseOctal:       {"0o", "0O"},
+	baseHexadecimal: {"0x", "0X"},
+}
+
+// cutBasePrefix removes the prefix of the number base from the unsigned number s, and reports whether it was found.
+func cutBasePrefix(s string, base numberBase) (string, bool) {
+	for _, prefix := range basePrefixes[base] {
+		rest, ok := strings.CutPrefix(s, prefix)
+		// the sign cannot follow the prefix, such as in "0x-1"
+		if ok && !strings.HasPrefix(rest, "-") && !strings.HasPrefix(rest, "+") {
+			return rest, true
+		}
 	}
+	return s, false
+}
+
+// hasBasePrefix reports whether s starts with the prefix of one of the number bases, such as "0x".
+func hasBasePrefix(s string) bool {
+	for _, prefixes := range basePrefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(s, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeBasePrefix removes the prefix accepted with [WithOptionalBasePrefix],
+// and the leading zeros that imply the octal base with [WithBaseAutoDetection] when [WithBasePrefixDetection] is used.
+func (pc *parseConfig) normalizeBasePrefix(s string) string {
+	sign, digits := "", s
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, digits = s[:1], s[1:]
+	}
+
+	switch {
+	case pc.numberBase == baseAuto && pc.prefixDetection:
+		// "010" and "0_10" are parsed as "10", the decimal number, instead of the octal one
+		for len(digits) > 1 && digits[0] == '0' && (isDigits(digits[1:2]) || digits[1] == '_') {
+			rest := strings.TrimPrefix(digits[1:], "_")
+			if hasBasePrefix(rest) {
+				// "00x10" is not a hexadecimal number, the zeros are kept so it is rejected
+				break
+			}
+			digits = rest
+		}
+	case pc.optionalBasePrefix:
+		digits, _ = cutBasePrefix(digits, pc.numberBase)
+	}
+
+	return sign + digits
 }
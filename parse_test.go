@@ -3,6 +3,7 @@ package safecast_test
 import (
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/ccoveille/go-safecast/v2"
@@ -61,6 +62,7 @@ func ExampleParse_with_options() {
 }
 
 type MapTestParse[TypeOutput safecast.Number] struct {
+	Parse          func(s string, opts ...safecast.ParseOption) (TypeOutput, error) // safecast.Parse when nil
 	Input          string
 	ParseOptions   []safecast.ParseOption
 	ExpectedOutput TypeOutput
@@ -81,7 +83,12 @@ func (mt MapTestParse[O]) Run(t *testing.T) {
 		}
 	}(t)
 
-	out, err := safecast.Parse[O](mt.Input, mt.ParseOptions...)
+	parse := mt.Parse
+	if parse == nil {
+		parse = safecast.Parse[O]
+	}
+
+	out, err := parse(mt.Input, mt.ParseOptions...)
 	if mt.ExpectedError != nil {
 		requireErrorIs(t, err, safecast.ErrConversionIssue)
 		requireErrorIs(t, err, mt.ExpectedError)
@@ -118,6 +125,8 @@ func TestParse(t *testing.T) {
 		"hexadecimal prefix without options":         MapTestParse[uint]{Input: "0x42", ExpectedError: safecast.ErrStringConversion},
 		"hexadecimal prefix with base auto":          MapTestParse[uint]{Input: "0x42", ExpectedOutput: 66, ParseOptions: []safecast.ParseOption{safecast.WithBaseAutoDetection()}},
 		"hexadecimal without prefix with hex option": MapTestParse[uint]{Input: "42", ExpectedOutput: 66, ParseOptions: []safecast.ParseOption{safecast.WithBaseHexadecimal()}},
+		"float with hex option":                      MapTestParse[float64]{Input: "1.5", ExpectedOutput: 1.5, ParseOptions: []safecast.ParseOption{safecast.WithBaseHexadecimal()}},
+		"float with binary option":                   MapTestParse[float64]{Input: "1.5", ExpectedOutput: 1.5, ParseOptions: []safecast.ParseOption{safecast.WithBaseBinary()}},
 
 		"boolean string":       MapTestParse[uint]{Input: "true", ExpectedError: safecast.ErrStringConversion},
 		"short boolean string": MapTestParse[uint]{Input: "t", ExpectedError: safecast.ErrStringConversion},
@@ -385,6 +394,190 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestParse_withConvertOptions(t *testing.T) {
+	for name, c := range map[string]TestRunner{
+		"saturation": MapTestParse[uint8]{
+			Input:          "1000",
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithSaturation())},
+			ExpectedOutput: math.MaxUint8,
+		},
+		"decimal loss": MapTestParse[int]{
+			Input:         "4.2",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"rounding": MapTestParse[int]{
+			Input:          "-4.5",
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundHalfAwayFromZero))},
+			ExpectedOutput: -5,
+		},
+		"options are accumulated": MapTestParse[int]{
+			Input: "4.5",
+			ParseOptions: []safecast.ParseOption{
+				safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundCeil)),
+				safecast.WithConvertOptions(safecast.WithDecimalLossReport()),
+			},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"with base": MapTestParse[int8]{
+			Input:          "FF",
+			ParseOptions:   []safecast.ParseOption{safecast.WithBaseHexadecimal(), safecast.WithConvertOptions(safecast.WithSaturation())},
+			ExpectedOutput: math.MaxInt8,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func TestParse_exactDecimal(t *testing.T) {
+	for name, c := range map[string]TestRunner{
+		"max uint64 with fractional part": MapTestParse[uint64]{
+			Input:          "18446744073709551615.0",
+			ExpectedOutput: math.MaxUint64,
+		},
+		"max uint64 + 1 with fractional part": MapTestParse[uint64]{
+			Input:         "18446744073709551616.0",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"max int64 with fractional part": MapTestParse[int64]{
+			Input:          "9223372036854775807.9",
+			ExpectedOutput: math.MaxInt64,
+		},
+		"min int64 with fractional part": MapTestParse[int64]{
+			Input:          "-9223372036854775808.9",
+			ExpectedOutput: math.MinInt64,
+		},
+		"exponent": MapTestParse[int]{
+			Input:          "1e3",
+			ExpectedOutput: 1000,
+		},
+		"exponent with fractional part": MapTestParse[int]{
+			Input:          "1.5e2",
+			ExpectedOutput: 150,
+		},
+		"uppercase negative exponent": MapTestParse[int]{
+			Input:          "15000E-2",
+			ExpectedOutput: 150,
+		},
+		"exponent with leading plus": MapTestParse[uint8]{
+			Input:          "+2.5e+1",
+			ExpectedOutput: 25,
+		},
+		"exponent with base auto": MapTestParse[int]{
+			Input:          "1e3",
+			ParseOptions:   []safecast.ParseOption{safecast.WithBaseAutoDetection()},
+			ExpectedOutput: 1000,
+		},
+		"exponent is a digit with base hexadecimal": MapTestParse[int]{
+			Input:          "1e3",
+			ParseOptions:   []safecast.ParseOption{safecast.WithBaseHexadecimal()},
+			ExpectedOutput: 0x1e3,
+		},
+		"hexadecimal with base auto": MapTestParse[int]{
+			Input:          "0x1e3",
+			ParseOptions:   []safecast.ParseOption{safecast.WithBaseAutoDetection()},
+			ExpectedOutput: 0x1e3,
+		},
+		"exponent to float": MapTestParse[float64]{
+			Input:          "1e3",
+			ExpectedOutput: 1000,
+		},
+		"exponent overflows": MapTestParse[uint8]{
+			Input:         "1e3",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 1000 (float64) is greater than 255 (uint8)",
+		},
+		"overflow reports the float64": MapTestParse[uint8]{
+			Input:         "1000.5",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 1000.5 (float64) is greater than 255 (uint8)",
+		},
+		"overflow beyond float64": MapTestParse[int]{
+			Input:         "1e400",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 1e400 (string) is greater than",
+		},
+		"huge exponent": MapTestParse[uint64]{
+			Input:         "1e1000000000000",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"huge negative exponent": MapTestParse[int64]{
+			Input:         "-1e1000000000000",
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"tiny value": MapTestParse[int]{
+			Input:          "1e-1000000000000",
+			ExpectedOutput: 0,
+		},
+		"tiny value rounded up": MapTestParse[int]{
+			Input:          "1e-1000000000000",
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundCeil))},
+			ExpectedOutput: 1,
+		},
+		"tiny value with decimal loss": MapTestParse[int]{
+			Input:         "1e-1000000000000",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"zero with huge exponent": MapTestParse[int]{
+			Input:          "0.000e1000000000000",
+			ExpectedOutput: 0,
+		},
+		"decimal loss on exact value": MapTestParse[uint64]{
+			Input:         "18446744073709551615.5",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"no decimal loss with exponent": MapTestParse[int]{
+			Input:          "1.25e2",
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedOutput: 125,
+		},
+		"long integer part": MapTestParse[int]{
+			Input:         "1" + zeros(2_000_000) + ".5",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"long fractional part above half": MapTestParse[int]{
+			Input:          "2.5" + zeros(2_000_000) + "1",
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundHalfToEven))},
+			ExpectedOutput: 3,
+		},
+		"long fractional part at half": MapTestParse[int]{
+			Input:          "2.5" + zeros(2_000_000),
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundHalfToEven))},
+			ExpectedOutput: 2,
+		},
+		"long fractional part with decimal loss": MapTestParse[int]{
+			Input:         "-2." + zeros(2_000_000) + "1",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"long fractional part without decimal loss": MapTestParse[uint64]{
+			Input:          "18446744073709551615." + zeros(2_000_000),
+			ParseOptions:   []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedOutput: math.MaxUint64,
+		},
+		"missing exponent": MapTestParse[int]{
+			Input:         "1e",
+			ExpectedError: safecast.ErrStringConversion,
+		},
+		"missing mantissa": MapTestParse[int]{
+			Input:         "e3",
+			ExpectedError: safecast.ErrStringConversion,
+		},
+		"fractional exponent": MapTestParse[int]{
+			Input:         "1e1.5",
+			ExpectedError: safecast.ErrStringConversion,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
 type MapMustParseTest[TypeOutput safecast.Number] struct {
 	Input          string
 	ParseOptions   []safecast.ParseOption
@@ -0,0 +1,198 @@
+package safecast_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParse_withOptionalBasePrefix(t *testing.T) {
+	for _, base := range []struct {
+		name     string
+		option   safecast.ParseOption
+		prefixes []string
+		digits   string
+		value    int64
+	}{
+		{name: "hexadecimal", option: safecast.WithBaseHexadecimal(), prefixes: []string{"0x", "0X"}, digits: "2a", value: 42},
+		{name: "octal", option: safecast.WithBaseOctal(), prefixes: []string{"0o", "0O"}, digits: "52", value: 42},
+		{name: "binary", option: safecast.WithBaseBinary(), prefixes: []string{"0b", "0B"}, digits: "101010", value: 42},
+	} {
+		options := []safecast.ParseOption{base.option, safecast.WithOptionalBasePrefix()}
+
+		for _, prefix := range append([]string{""}, base.prefixes...) {
+			for _, sign := range []string{"", "+", "-"} {
+				input := sign + prefix + base.digits
+				expected := base.value
+				if sign == "-" {
+					expected = -expected
+				}
+
+				t.Run(base.name+" "+input+" to int64", func(t *testing.T) {
+					if sign == "+" {
+						// the leading "+" is rejected for the integer types, as with the other options
+						MapTestParse[int64]{Input: input, ParseOptions: options, ExpectedError: safecast.ErrStringConversion}.Run(t)
+						return
+					}
+					MapTestParse[int64]{Input: input, ParseOptions: options, ExpectedOutput: expected}.Run(t)
+				})
+
+				t.Run(base.name+" "+input+" to uint64", func(t *testing.T) {
+					switch sign {
+					case "":
+						MapTestParse[uint64]{Input: input, ParseOptions: options, ExpectedOutput: uint64(expected)}.Run(t)
+					case "+":
+						MapTestParse[uint64]{Input: input, ParseOptions: options, ExpectedError: safecast.ErrStringConversion}.Run(t)
+					case "-":
+						MapTestParse[uint64]{Input: input, ParseOptions: options, ExpectedError: safecast.ErrExceedMinimumValue}.Run(t)
+					}
+				})
+
+				if prefix != "" {
+					t.Run(base.name+" "+input+" without option", func(t *testing.T) {
+						MapTestParse[int64]{Input: input, ParseOptions: []safecast.ParseOption{base.option}, ExpectedError: safecast.ErrStringConversion}.Run(t)
+					})
+				}
+			}
+		}
+	}
+
+	hex := []safecast.ParseOption{safecast.WithBaseHexadecimal(), safecast.WithOptionalBasePrefix()}
+	octal := []safecast.ParseOption{safecast.WithBaseOctal(), safecast.WithOptionalBasePrefix()}
+	decimal := []safecast.ParseOption{safecast.WithBaseDecimal(), safecast.WithOptionalBasePrefix()}
+
+	for name, c := range map[string]TestRunner{
+		"uppercase digits":           MapTestParse[uint8]{Input: "0xFF", ParseOptions: hex, ExpectedOutput: 255},
+		"binary prefix is a digit":   MapTestParse[uint8]{Input: "0b1", ParseOptions: hex, ExpectedOutput: 0xb1},
+		"other base prefix":          MapTestParse[uint8]{Input: "0x17", ParseOptions: octal, ExpectedError: safecast.ErrStringConversion},
+		"octal legacy prefix":        MapTestParse[uint8]{Input: "017", ParseOptions: octal, ExpectedOutput: 0o17},
+		"no prefix in decimal":       MapTestParse[uint8]{Input: "0x17", ParseOptions: decimal, ExpectedError: safecast.ErrStringConversion},
+		"only prefix":                MapTestParse[uint8]{Input: "0x", ParseOptions: hex, ExpectedError: safecast.ErrStringConversion},
+		"sign after prefix":          MapTestParse[int8]{Input: "0x-1", ParseOptions: hex, ExpectedError: safecast.ErrStringConversion},
+		"plus sign after prefix":     MapTestParse[int8]{Input: "0x+1", ParseOptions: hex, ExpectedError: safecast.ErrStringConversion},
+		"two prefixes":               MapTestParse[uint16]{Input: "0x0x1", ParseOptions: hex, ExpectedError: safecast.ErrStringConversion},
+		"overflow":                   MapTestParse[uint8]{Input: "0x100", ParseOptions: hex, ExpectedError: safecast.ErrExceedMaximumValue, ErrorContains: "256 (uint64)"},
+		"underscore":                 MapTestParse[uint16]{Input: "0x1_0", ParseOptions: hex, ExpectedError: safecast.ErrStringConversion},
+		"with base 16":               MapTestParse[uint8]{Input: "0xff", ParseOptions: []safecast.ParseOption{safecast.WithBase(16), safecast.WithOptionalBasePrefix()}, ExpectedOutput: 255},
+		"with strict":                MapTestParse[uint8]{Input: "0xff", ParseOptions: append(hex, safecast.WithStrict()), ExpectedOutput: 255},
+		"with strict uppercase":      MapTestParse[uint8]{Input: "0XFF", ParseOptions: append(hex, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "uppercase letter"},
+		"with strict leading zero":   MapTestParse[uint8]{Input: "0x0f", ParseOptions: append(hex, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"with strict missing prefix": MapTestParse[uint8]{Input: "ff", ParseOptions: append(hex, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing base prefix"},
+		"with strict other prefix":   MapTestParse[uint8]{Input: "0b1", ParseOptions: append(hex, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing base prefix"},
+		"with strict in decimal":     MapTestParse[uint8]{Input: "17", ParseOptions: append(decimal, safecast.WithStrict()), ExpectedOutput: 17},
+		"with strict negative zero":  MapTestParse[int8]{Input: "-0x0", ParseOptions: append(hex, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "negative zero"},
+		"with lenient":               MapTestParse[uint8]{Input: " +0xff ", ParseOptions: append(hex, safecast.WithLenient()), ExpectedOutput: 255},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func TestParse_withBasePrefixDetection(t *testing.T) {
+	for _, prefix := range []struct {
+		prefix string
+		digits string
+	}{
+		{prefix: "", digits: "42"},
+		{prefix: "0x", digits: "2a"},
+		{prefix: "0X", digits: "2A"},
+		{prefix: "0o", digits: "52"},
+		{prefix: "0O", digits: "52"},
+		{prefix: "0b", digits: "101010"},
+		{prefix: "0B", digits: "101010"},
+		{prefix: "0", digits: "42"},
+		{prefix: "00", digits: "42"},
+	} {
+		for _, sign := range []string{"", "+", "-"} {
+			input := sign + prefix.prefix + prefix.digits
+			expected := int64(42)
+			if sign == "-" {
+				expected = -expected
+			}
+
+			t.Run(input+" to int64", func(t *testing.T) {
+				if sign == "+" {
+					MapTestParse[int64]{Input: input, ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection()}, ExpectedError: safecast.ErrStringConversion}.Run(t)
+					return
+				}
+				MapTestParse[int64]{Input: input, ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection()}, ExpectedOutput: expected}.Run(t)
+			})
+
+			t.Run(input+" to uint64", func(t *testing.T) {
+				switch sign {
+				case "":
+					MapTestParse[uint64]{Input: input, ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection()}, ExpectedOutput: uint64(expected)}.Run(t)
+				case "+":
+					MapTestParse[uint64]{Input: input, ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection()}, ExpectedError: safecast.ErrStringConversion}.Run(t)
+				case "-":
+					MapTestParse[uint64]{Input: input, ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection()}, ExpectedError: safecast.ErrExceedMinimumValue}.Run(t)
+				}
+			})
+		}
+	}
+
+	detection := []safecast.ParseOption{safecast.WithBasePrefixDetection()}
+
+	for name, c := range map[string]TestRunner{
+		"legacy octal is decimal":        MapTestParse[int]{Input: "010", ParseOptions: detection, ExpectedOutput: 10},
+		"8 and 9 are valid":              MapTestParse[int]{Input: "089", ParseOptions: detection, ExpectedOutput: 89},
+		"zero":                           MapTestParse[int]{Input: "0", ParseOptions: detection, ExpectedOutput: 0},
+		"zeros":                          MapTestParse[int]{Input: "-000", ParseOptions: detection, ExpectedOutput: 0},
+		"underscores":                    MapTestParse[int]{Input: "1_000", ParseOptions: detection, ExpectedOutput: 1000},
+		"underscore after zero":          MapTestParse[int]{Input: "0_10", ParseOptions: detection, ExpectedOutput: 10},
+		"underscore after prefix":        MapTestParse[int]{Input: "0x_ff", ParseOptions: detection, ExpectedOutput: 0xff},
+		"float":                          MapTestParse[float64]{Input: "010.5", ParseOptions: detection, ExpectedOutput: 10.5},
+		"exponent":                       MapTestParse[int]{Input: "01e2", ParseOptions: detection, ExpectedOutput: 100},
+		"trailing underscore":            MapTestParse[int]{Input: "0_", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"double underscore":              MapTestParse[int]{Input: "0__1", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"zero before hex prefix":         MapTestParse[int]{Input: "00x10", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"zeros before hex prefix":        MapTestParse[int]{Input: "-000x10", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"underscore before hex prefix":   MapTestParse[int]{Input: "0_0x10", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"zero before binary prefix":      MapTestParse[int]{Input: "00b10", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"zero before octal prefix":       MapTestParse[int]{Input: "00O10", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion},
+		"invalid octal digit":            MapTestParse[int]{Input: "0o8", ParseOptions: detection, ExpectedError: safecast.ErrStringConversion, ErrorContains: "(base auto-detection)"},
+		"overflow":                       MapTestParse[uint8]{Input: "0256", ParseOptions: detection, ExpectedError: safecast.ErrExceedMaximumValue},
+		"legacy octal with strict":       MapTestParse[int]{Input: "010", ParseOptions: append(detection, safecast.WithStrict()), ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"legacy octal with autodetect":   MapTestParse[int]{Input: "010", ParseOptions: []safecast.ParseOption{safecast.WithBaseAutoDetection()}, ExpectedOutput: 8},
+		"auto-detection overrides":       MapTestParse[int]{Input: "010", ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection(), safecast.WithBaseAutoDetection()}, ExpectedOutput: 8},
+		"fixed base overrides detection": MapTestParse[int]{Input: "010", ParseOptions: []safecast.ParseOption{safecast.WithBasePrefixDetection(), safecast.WithBaseBinary()}, ExpectedOutput: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleWithOptionalBasePrefix() {
+	i, err := safecast.Parse[uint8]("0xFF", safecast.WithBaseHexadecimal(), safecast.WithOptionalBasePrefix())
+	fmt.Println(i, err)
+
+	i, err = safecast.Parse[uint8]("FF", safecast.WithBaseHexadecimal(), safecast.WithOptionalBasePrefix())
+	fmt.Println(i, err)
+
+	_, err = safecast.Parse[uint8]("0xFF", safecast.WithBaseHexadecimal())
+	fmt.Println(err)
+
+	// Output:
+	// 255 <nil>
+	// 255 <nil>
+	// conversion issue: cannot convert from `0xFF` to uint8 (base hexadecimal)
+}
+
+func ExampleWithBasePrefixDetection() {
+	i, err := safecast.Parse[uint8]("010", safecast.WithBasePrefixDetection())
+	fmt.Println(i, err)
+
+	i, err = safecast.Parse[uint8]("0o10", safecast.WithBasePrefixDetection())
+	fmt.Println(i, err)
+
+	i, err = safecast.Parse[uint8]("0x10", safecast.WithBasePrefixDetection())
+	fmt.Println(i, err)
+
+	// Output:
+	// 10 <nil>
+	// 8 <nil>
+	// 16 <nil>
+}
@@ -0,0 +1,99 @@
+package safecast
+
+import (
+	"math/big"
+	"strings"
+)
+
+// quantityUnits are the units accepted by [ParseQuantity].
+var quantityUnits = newUnitTable(map[string]*big.Rat{
+	"": big.NewRat(1, 1),
+
+	// SI suffixes, powers of 1000
+	"n": new(big.Rat).Inv(powerOf(1000, 3)),
+	"u": new(big.Rat).Inv(powerOf(1000, 2)),
+	"m": new(big.Rat).Inv(powerOf(1000, 1)),
+	"k": powerOf(1000, 1),
+	"M": powerOf(1000, 2),
+	"G": powerOf(1000, 3),
+	"T": powerOf(1000, 4),
+	"P": powerOf(1000, 5),
+	"E": powerOf(1000, 6),
+
+	// binary suffixes, powers of 1024
+	"Ki": powerOf(1024, 1),
+	"Mi": powerOf(1024, 2),
+	"Gi": powerOf(1024, 3),
+	"Ti": powerOf(1024, 4),
+	"Pi": powerOf(1024, 5),
+	"Ei": powerOf(1024, 6),
+})
+
+// ParseQuantity parses a quantity with an optional suffix, as used by Kubernetes for CPU or counts,
+// such as "250m", "2k", "1.5M", or "3Ei", and converts it to the desired [Number] type.
+//
+// # Suffixes
+//
+//   - SI suffixes are powers of 1000: "n" (nano), "u" (micro), "m" (milli), "k", "M", "G", "T", "P", and "E".
+//   - Binary suffixes are powers of 1024: "Ki", "Mi", "Gi", "Ti", "Pi", and "Ei".
+//
+// The suffixes are case-sensitive, as "m" (milli) and "M" (mega) differ, so [WithCaseInsensitiveUnits] is ignored.
+//
+// The decimal exponent form is accepted instead of a suffix, such as "1e3" or "1.5E-3".
+// "E" alone is the exa suffix, so "1E" is 10^18 while "1E3" is 1000.
+//
+// # Behavior
+//
+// The value is computed exactly once multiplied by the suffix, then it is converted like [ConvertFromBigRat] does,
+// so "250m" is truncated to 0 for integer types, unless another rounding mode is set with [WithRounding].
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the quantity, once multiplied by the suffix,
+//     is outside the range of the desired type (example: "3Ei" to int32).
+//   - [ErrDecimalLoss] is wrapped when the quantity is not an integer and [WithDecimalLossReport] is used
+//     with [WithConvertOptions] (example: "250m" to int).
+//   - [ErrStringConversion] is wrapped when the string is not a valid quantity (example: "5X").
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// The options are the same as for [ParseSize].
+func ParseQuantity[NumOut Number](s string, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		// the suffixes cannot be case-insensitive
+		options.caseInsensitiveUnits = false
+		if number, multiplier, ok := cutDecimalExponent(s); ok {
+			return parseMultiplied[NumOut](s, number, multiplier, options)
+		}
+		return parseWithUnits[NumOut](s, quantityUnits, options)
+	})
+}
+
+// maxQuantityExponent is added to the number of characters of the number to bound the exponent of a quantity:
+// a larger exponent leads to a value out of the range of all the types, such as "1e1000000000",
+// and a smaller one to a value rounded the same way as a value closer to zero.
+const maxQuantityExponent = 400
+
+// cutDecimalExponent returns the number before the decimal exponent of s, such as "1.5" in "1.5e3",
+// and the power of 10 of the exponent.
+//
+// It reports false if s doesn't end with an exponent, such as "1E" where "E" is the exa suffix.
+func cutDecimalExponent(s string) (number string, multiplier *big.Rat, ok bool) {
+	i := strings.LastIndexAny(s, "eE")
+	if i <= 0 {
+		return "", nil, false
+	}
+
+	var exponent int64
+	if !parseExponent(s[i+1:], &exponent) {
+		return "", nil, false
+	}
+
+	number = s[:i]
+	bound := int64(len(number)) + maxQuantityExponent
+	exponent = max(-bound, min(exponent, bound))
+
+	if exponent >= 0 {
+		return number, powerOf(10, exponent), true
+	}
+	return number, new(big.Rat).Inv(powerOf(10, -exponent)), true
+}
@@ -0,0 +1,87 @@
+package safecast_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParseQuantity(t *testing.T) {
+	decimalLoss := []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())}
+
+	for name, c := range map[string]TestRunner{
+		"no suffix":                   MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "42", ExpectedOutput: 42},
+		"milli to float":              MapTestParse[float64]{Parse: safecast.ParseQuantity[float64], Input: "250m", ExpectedOutput: 0.25},
+		"milli to int":                MapTestParse[int64]{Parse: safecast.ParseQuantity[int64], Input: "2500m", ExpectedOutput: 2},
+		"milli truncated":             MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "250m", ExpectedOutput: 0},
+		"milli rounded":               MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1500m", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundCeil))}, ExpectedOutput: 2},
+		"micro":                       MapTestParse[float64]{Parse: safecast.ParseQuantity[float64], Input: "5u", ExpectedOutput: 0.000005},
+		"nano":                        MapTestParse[float32]{Parse: safecast.ParseQuantity[float32], Input: "100n", ExpectedOutput: 1e-7},
+		"kilo":                        MapTestParse[int16]{Parse: safecast.ParseQuantity[int16], Input: "2k", ExpectedOutput: 2000},
+		"mega":                        MapTestParse[int32]{Parse: safecast.ParseQuantity[int32], Input: "1.5M", ExpectedOutput: 1_500_000},
+		"giga":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1G", ExpectedOutput: 1_000_000_000},
+		"tera":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1T", ExpectedOutput: 1_000_000_000_000},
+		"peta":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1P", ExpectedOutput: 1_000_000_000_000_000},
+		"exa":                         MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1E", ExpectedOutput: 1_000_000_000_000_000_000},
+		"kibi":                        MapTestParse[uint16]{Parse: safecast.ParseQuantity[uint16], Input: "1Ki", ExpectedOutput: 1024},
+		"mebi":                        MapTestParse[uint32]{Parse: safecast.ParseQuantity[uint32], Input: "1.5Mi", ExpectedOutput: 3 << 19},
+		"gibi":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1Gi", ExpectedOutput: 1 << 30},
+		"tebi":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1Ti", ExpectedOutput: 1 << 40},
+		"pebi":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "1Pi", ExpectedOutput: 1 << 50},
+		"exbi":                        MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "3Ei", ExpectedOutput: 3 << 60},
+		"negative":                    MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "-2k", ExpectedOutput: -2000},
+		"exact milli":                 MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "2000m", ParseOptions: decimalLoss, ExpectedOutput: 2},
+		"exponent":                    MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1e3", ExpectedOutput: 1000},
+		"uppercase exponent":          MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1.5E3", ExpectedOutput: 1500},
+		"positive exponent":           MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "2e+2", ExpectedOutput: 200},
+		"negative exponent":           MapTestParse[float64]{Parse: safecast.ParseQuantity[float64], Input: "-25e-2", ExpectedOutput: -0.25},
+		"exa is not an exponent":      MapTestParse[uint64]{Parse: safecast.ParseQuantity[uint64], Input: "2E", ExpectedOutput: 2_000_000_000_000_000_000},
+		"tiny exponent":               MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1e-1000000000", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithRounding(safecast.RoundCeil))}, ExpectedOutput: 1},
+		"case-insensitive is ignored": MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1000m", ParseOptions: []safecast.ParseOption{safecast.WithCaseInsensitiveUnits()}, ExpectedOutput: 1},
+
+		"decimal loss": MapTestParse[int]{
+			Parse:         safecast.ParseQuantity[int],
+			Input:         "250m",
+			ParseOptions:  decimalLoss,
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"overflow": MapTestParse[int32]{
+			Parse:         safecast.ParseQuantity[int32],
+			Input:         "3Ei",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 3Ei (string) is greater than 2147483647 (int32)",
+		},
+		"underflow":             MapTestParse[int8]{Parse: safecast.ParseQuantity[int8], Input: "-1k", ExpectedError: safecast.ErrExceedMinimumValue},
+		"float overflow":        MapTestParse[float32]{Parse: safecast.ParseQuantity[float32], Input: "1" + zeros(30) + "E", ExpectedError: safecast.ErrExceedMaximumValue},
+		"unknown suffix":        MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "5X", ExpectedError: safecast.ErrStringConversion, ErrorContains: "cannot convert from `5X` to int"},
+		"size unit":             MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "5KiB", ExpectedError: safecast.ErrStringConversion},
+		"lowercase kibi":        MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "5ki", ExpectedError: safecast.ErrStringConversion},
+		"huge exponent":         MapTestParse[float64]{Parse: safecast.ParseQuantity[float64], Input: "1e1000000000", ExpectedError: safecast.ErrExceedMaximumValue},
+		"exponent decimal loss": MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "15e-1", ParseOptions: decimalLoss, ExpectedError: safecast.ErrDecimalLoss},
+		"missing exponent":      MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1e", ExpectedError: safecast.ErrStringConversion},
+		"exponent and suffix":   MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "1e3k", ExpectedError: safecast.ErrStringConversion},
+		"trailing space":        MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "5 ", ExpectedError: safecast.ErrStringConversion},
+		"empty":                 MapTestParse[int]{Parse: safecast.ParseQuantity[int], Input: "", ExpectedError: safecast.ErrStringConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleParseQuantity() {
+	cpu, err := safecast.ParseQuantity[float64]("250m")
+	fmt.Println(cpu, err)
+
+	memory, err := safecast.ParseQuantity[uint64]("3Ei")
+	fmt.Println(memory, err)
+
+	_, err = safecast.ParseQuantity[int]("250m", safecast.WithConvertOptions(safecast.WithDecimalLossReport()))
+	fmt.Println(err)
+
+	// Output:
+	// 0.25 <nil>
+	// 3458764513820540928 <nil>
+	// conversion issue: decimal loss during conversion
+}
@@ -0,0 +1,101 @@
+package safecast
+
+import (
+	"math/big"
+	"strings"
+)
+
+// ratioUnits are the units accepted by [ParseRatio], [ParsePercent], and [ParseBasisPoints].
+var ratioUnits = newUnitTable(map[string]*big.Rat{
+	"":  big.NewRat(1, 1),
+	"%": big.NewRat(1, 100),
+})
+
+// ParseRatio parses a ratio, such as "0.125", "1/8", or "12.5%", and converts it to the desired [Number] type.
+//
+// The three examples give 0.125, see [ParsePercent] and [ParseBasisPoints] to get the value in another scale.
+//
+// # Syntax
+//
+//   - A decimal number, such as "0.125".
+//   - A fraction of two decimal numbers, such as "1/8".
+//   - A percentage, such as "12.5%". A space is accepted before the "%" sign.
+//
+// # Behavior
+//
+// The ratio is computed exactly, then it is converted like [ConvertFromBigRat] does:
+// it is truncated toward zero for integer types, and [ErrDecimalLoss] can be reported with [WithDecimalLossReport]
+// using [WithConvertOptions].
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the ratio
+//     is outside the range of the desired type.
+//   - [ErrStringConversion] is wrapped when the string is not a valid ratio (example: "1/0" or "12.5%%").
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// The options are the same as for [ParseSize].
+func ParseRatio[NumOut Number](s string, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		return parseScaledRatio[NumOut](s, big.NewRat(1, 1), options)
+	})
+}
+
+// ParsePercent parses a ratio as [ParseRatio] does, and converts it to the desired [Number] type as a percentage.
+//
+// For example, "12.5%", "0.125", and "1/8" give 12.5, or 12 for integer types.
+func ParsePercent[NumOut Number](s string, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		return parseScaledRatio[NumOut](s, big.NewRat(100, 1), options)
+	})
+}
+
+// ParseBasisPoints parses a ratio as [ParseRatio] does, and converts it to the desired [Number] type
+// as a number of basis points, a basis point being a hundredth of a percent.
+//
+// For example, "12.5%", "0.125", and "1/8" give 1250, that fits in an uint16.
+func ParseBasisPoints[NumOut Number](s string, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		return parseScaledRatio[NumOut](s, big.NewRat(10000, 1), options)
+	})
+}
+
+// parseScaledRatio parses a ratio, and converts it multiplied by scale to the desired type.
+func parseScaledRatio[NumOut Number](orig string, scale *big.Rat, options *parseConfig) (NumOut, error) {
+	syntaxError := func() (NumOut, error) {
+		return 0, newConversionError[NumOut](orig, ErrStringConversion)
+	}
+
+	s, multiplier, ok := ratioUnits.cut(orig, false)
+	if !ok {
+		return syntaxError()
+	}
+
+	numerator, denominator, isFraction := strings.Cut(s, "/")
+	if isFraction && multiplier.Cmp(big.NewRat(1, 1)) != 0 {
+		// "1/8%" is ambiguous
+		return syntaxError()
+	}
+
+	value, ok := parseDecimalRat(numerator, options)
+	if !ok {
+		return syntaxError()
+	}
+
+	if isFraction {
+		divisor, ok := parseDecimalRat(denominator, options)
+		if !ok || divisor.Sign() == 0 {
+			return syntaxError()
+		}
+		value.Quo(value, divisor)
+	}
+
+	value.Mul(value, multiplier)
+	value.Mul(value, scale)
+
+	converted, err := ConvertFromBigRat[NumOut](value, options.convertOptions...)
+	if err != nil {
+		return converted, withValue(orig, err)
+	}
+	return converted, nil
+}
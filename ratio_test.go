@@ -0,0 +1,78 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParseRatio(t *testing.T) {
+	decimalLoss := []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())}
+
+	for name, c := range map[string]TestRunner{
+		"decimal":                  MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "0.125", ExpectedOutput: 0.125},
+		"fraction":                 MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "1/8", ExpectedOutput: 0.125},
+		"percent":                  MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "12.5%", ExpectedOutput: 0.125},
+		"percent with space":       MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "12.5 %", ExpectedOutput: 0.125},
+		"negative":                 MapTestParse[float32]{Parse: safecast.ParseRatio[float32], Input: "-3/4", ExpectedOutput: -0.75},
+		"decimal fraction":         MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "1.5/0.5", ExpectedOutput: 3},
+		"integer":                  MapTestParse[int]{Parse: safecast.ParseRatio[int], Input: "200%", ExpectedOutput: 2},
+		"integer truncated":        MapTestParse[int]{Parse: safecast.ParseRatio[int], Input: "7/2", ExpectedOutput: 3},
+		"locale":                   MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "12,5 %", ParseOptions: []safecast.ParseOption{safecast.WithLocale(safecast.LocaleFrench)}, ExpectedOutput: 0.125},
+		"percentage":               MapTestParse[float64]{Parse: safecast.ParsePercent[float64], Input: "12.5%", ExpectedOutput: 12.5},
+		"percentage of ratio":      MapTestParse[float64]{Parse: safecast.ParsePercent[float64], Input: "0.125", ExpectedOutput: 12.5},
+		"percentage of fraction":   MapTestParse[float64]{Parse: safecast.ParsePercent[float64], Input: "1/8", ExpectedOutput: 12.5},
+		"percentage truncated":     MapTestParse[uint8]{Parse: safecast.ParsePercent[uint8], Input: "1/3", ExpectedOutput: 33},
+		"basis points":             MapTestParse[uint16]{Parse: safecast.ParseBasisPoints[uint16], Input: "12.5%", ExpectedOutput: 1250},
+		"basis points of ratio":    MapTestParse[uint16]{Parse: safecast.ParseBasisPoints[uint16], Input: "0.125", ExpectedOutput: 1250},
+		"basis points of fraction": MapTestParse[uint16]{Parse: safecast.ParseBasisPoints[uint16], Input: "1/8", ExpectedOutput: 1250},
+		"basis points max":         MapTestParse[uint16]{Parse: safecast.ParseBasisPoints[uint16], Input: "655.35%", ExpectedOutput: math.MaxUint16},
+		"exact basis points":       MapTestParse[uint16]{Parse: safecast.ParseBasisPoints[uint16], Input: "0.01%", ParseOptions: decimalLoss, ExpectedOutput: 1},
+
+		"overflow": MapTestParse[uint16]{
+			Parse:         safecast.ParseBasisPoints[uint16],
+			Input:         "1000%",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 1000% (string) is greater than 65535 (uint16)",
+		},
+		"negative to unsigned": MapTestParse[uint8]{Parse: safecast.ParsePercent[uint8], Input: "-1%", ExpectedError: safecast.ErrExceedMinimumValue},
+		"decimal loss": MapTestParse[uint16]{
+			Parse:         safecast.ParseBasisPoints[uint16],
+			Input:         "0.125%",
+			ParseOptions:  decimalLoss,
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"division by zero": MapTestParse[float64]{
+			Parse:         safecast.ParseRatio[float64],
+			Input:         "1/0",
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "cannot convert from `1/0` to float64",
+		},
+		"fraction of percent": MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "1/8%", ExpectedError: safecast.ErrStringConversion},
+		"two fractions":       MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "1/2/3", ExpectedError: safecast.ErrStringConversion},
+		"two percent signs":   MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "12.5%%", ExpectedError: safecast.ErrStringConversion},
+		"empty numerator":     MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "/8", ExpectedError: safecast.ErrStringConversion},
+		"trailing space":      MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "0.5 ", ExpectedError: safecast.ErrStringConversion},
+		"empty":               MapTestParse[float64]{Parse: safecast.ParseRatio[float64], Input: "", ExpectedError: safecast.ErrStringConversion},
+		"not a number":        MapTestParse[float64]{Parse: safecast.ParsePercent[float64], Input: "abc%", ExpectedError: safecast.ErrStringConversion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleParseBasisPoints() {
+	for _, s := range []string{"12.5%", "0.125", "1/8", "1000%"} {
+		bps, err := safecast.ParseBasisPoints[uint16](s)
+		fmt.Println(bps, err)
+	}
+
+	// Output:
+	// 1250 <nil>
+	// 1250 <nil>
+	// 1250 <nil>
+	// 0 conversion issue: 1000% (string) is greater than 65535 (uint16): maximum value for this type exceeded
+}
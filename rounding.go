@@ -0,0 +1,118 @@
+package safecast
+
+import (
+	"math"
+	"math/big"
+)
+
+// RoundingMode defines how a floating-point value is rounded when converted to an integer type.
+//
+// Use it with [WithRounding].
+type RoundingMode int
+
+const (
+	// RoundTruncate rounds toward zero (example: 2.7 gives 2, -2.7 gives -2).
+	//
+	// This is the default behavior of [Convert], and the one of Go type conversion.
+	RoundTruncate RoundingMode = iota
+
+	// RoundFloor rounds toward negative infinity (example: 2.7 gives 2, -2.2 gives -3).
+	RoundFloor
+
+	// RoundCeil rounds toward positive infinity (example: 2.2 gives 3, -2.7 gives -2).
+	RoundCeil
+
+	// RoundHalfAwayFromZero rounds to the nearest integer, and ties away from zero (example: 2.5 gives 3, -2.5 gives -3).
+	//
+	// This is the behavior of [math.Round].
+	RoundHalfAwayFromZero
+
+	// RoundHalfToEven rounds to the nearest integer, and ties to the nearest even integer (example: 2.5 gives 2, 3.5 gives 4).
+	//
+	// This is also known as banker's rounding, and is the behavior of [math.RoundToEven].
+	RoundHalfToEven
+
+	// RoundHalfUp rounds to the nearest integer, and ties toward positive infinity (example: 2.5 gives 3, -2.5 gives -2).
+	RoundHalfUp
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundTruncate:
+		return "truncate"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundHalfAwayFromZero:
+		return "half-away-from-zero"
+	case RoundHalfToEven:
+		return "half-to-even"
+	case RoundHalfUp:
+		return "half-up"
+	default:
+		return "unknown"
+	}
+}
+
+// round applies the rounding mode to f.
+//
+// Unknown modes fall back to [RoundTruncate].
+func (m RoundingMode) round(f float64) float64 {
+	switch m {
+	case RoundFloor:
+		return math.Floor(f)
+	case RoundCeil:
+		return math.Ceil(f)
+	case RoundHalfAwayFromZero:
+		return math.Round(f)
+	case RoundHalfToEven:
+		return math.RoundToEven(f)
+	case RoundHalfUp:
+		// f+0.5 cannot be used, as it may be rounded up by the addition itself (example: 0.49999999999999994)
+		// while the difference with the floor value is always exact.
+		floor := math.Floor(f)
+		if f-floor >= 0.5 {
+			return floor + 1
+		}
+		return floor
+	default:
+		return math.Trunc(f)
+	}
+}
+
+// roundRat applies the rounding mode to r, and reports whether the result is exact.
+//
+// Unknown modes fall back to [RoundTruncate].
+func (m RoundingMode) roundRat(r *big.Rat) (rounded *big.Int, exact bool) {
+	rem := new(big.Int)
+	rounded, rem = new(big.Int).QuoRem(r.Num(), r.Denom(), rem)
+
+	sign := rem.Sign()
+	if sign == 0 {
+		return rounded, true
+	}
+
+	// the denominator is always positive, so the remainder is compared to the half of it
+	// by comparing the doubled absolute value of the remainder to the denominator
+	half := new(big.Int).Lsh(rem.Abs(rem), 1).Cmp(r.Denom())
+
+	var awayFromZero bool
+	switch m {
+	case RoundFloor:
+		awayFromZero = sign < 0
+	case RoundCeil:
+		awayFromZero = sign > 0
+	case RoundHalfAwayFromZero:
+		awayFromZero = half >= 0
+	case RoundHalfToEven:
+		awayFromZero = half > 0 || half == 0 && rounded.Bit(0) == 1
+	case RoundHalfUp:
+		awayFromZero = half > 0 || half == 0 && sign > 0
+	}
+
+	if awayFromZero {
+		rounded.Add(rounded, big.NewInt(int64(sign)))
+	}
+	return rounded, false
+}
@@ -0,0 +1,197 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestConvert_withRounding(t *testing.T) {
+	withRounding := func(mode safecast.RoundingMode) []safecast.ConvertOption {
+		return []safecast.ConvertOption{safecast.WithRounding(mode)}
+	}
+
+	for _, tc := range []struct {
+		input float64
+		want  map[safecast.RoundingMode]int
+	}{
+		{input: 2.2, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 2, safecast.RoundFloor: 2, safecast.RoundCeil: 3,
+			safecast.RoundHalfAwayFromZero: 2, safecast.RoundHalfToEven: 2, safecast.RoundHalfUp: 2,
+		}},
+		{input: 2.5, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 2, safecast.RoundFloor: 2, safecast.RoundCeil: 3,
+			safecast.RoundHalfAwayFromZero: 3, safecast.RoundHalfToEven: 2, safecast.RoundHalfUp: 3,
+		}},
+		{input: 3.5, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 3, safecast.RoundFloor: 3, safecast.RoundCeil: 4,
+			safecast.RoundHalfAwayFromZero: 4, safecast.RoundHalfToEven: 4, safecast.RoundHalfUp: 4,
+		}},
+		{input: 2.7, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 2, safecast.RoundFloor: 2, safecast.RoundCeil: 3,
+			safecast.RoundHalfAwayFromZero: 3, safecast.RoundHalfToEven: 3, safecast.RoundHalfUp: 3,
+		}},
+		{input: -2.2, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: -2, safecast.RoundFloor: -3, safecast.RoundCeil: -2,
+			safecast.RoundHalfAwayFromZero: -2, safecast.RoundHalfToEven: -2, safecast.RoundHalfUp: -2,
+		}},
+		{input: -2.5, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: -2, safecast.RoundFloor: -3, safecast.RoundCeil: -2,
+			safecast.RoundHalfAwayFromZero: -3, safecast.RoundHalfToEven: -2, safecast.RoundHalfUp: -2,
+		}},
+		{input: -2.7, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: -2, safecast.RoundFloor: -3, safecast.RoundCeil: -2,
+			safecast.RoundHalfAwayFromZero: -3, safecast.RoundHalfToEven: -3, safecast.RoundHalfUp: -3,
+		}},
+		{input: 0.49999999999999994, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 0, safecast.RoundFloor: 0, safecast.RoundCeil: 1,
+			safecast.RoundHalfAwayFromZero: 0, safecast.RoundHalfToEven: 0, safecast.RoundHalfUp: 0,
+		}},
+		{input: 42, want: map[safecast.RoundingMode]int{
+			safecast.RoundTruncate: 42, safecast.RoundFloor: 42, safecast.RoundCeil: 42,
+			safecast.RoundHalfAwayFromZero: 42, safecast.RoundHalfToEven: 42, safecast.RoundHalfUp: 42,
+		}},
+	} {
+		for mode, want := range tc.want {
+			t.Run(fmt.Sprintf("%v with %s", tc.input, mode), func(t *testing.T) {
+				MapTest[float64, int]{Input: tc.input, Options: withRounding(mode), ExpectedOutput: want}.Run(t)
+				if float64(float32(tc.input)) == tc.input {
+					// the same tests can only be done with float32 when the value can be represented as float32
+					MapTest[float32, int]{Input: float32(tc.input), Options: withRounding(mode), ExpectedOutput: want}.Run(t)
+				}
+			})
+		}
+	}
+
+	for name, tt := range map[string]TestRunner{
+		"overflow once rounded up": MapTest[float64, uint8]{
+			Input:         255.6,
+			Options:       withRounding(safecast.RoundHalfUp),
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "255.6 (float64) is greater than 255 (uint8)",
+		},
+		"no overflow when truncated": MapTest[float64, uint8]{
+			Input:          255.6,
+			Options:        withRounding(safecast.RoundTruncate),
+			ExpectedOutput: 255,
+		},
+		"overflow once rounded to ceil": MapTest[float64, int8]{
+			Input:         127.1,
+			Options:       withRounding(safecast.RoundCeil),
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"underflow once rounded to floor": MapTest[float64, uint8]{
+			Input:         -0.1,
+			Options:       withRounding(safecast.RoundFloor),
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"no underflow when rounded to ceil": MapTest[float64, uint8]{
+			Input:          -0.9,
+			Options:        withRounding(safecast.RoundCeil),
+			ExpectedOutput: 0,
+		},
+		"no underflow when rounded to nearest": MapTest[float64, uint]{
+			Input:          -0.4,
+			Options:        withRounding(safecast.RoundHalfAwayFromZero),
+			ExpectedOutput: 0,
+		},
+		"underflow when rounded to nearest": MapTest[float32, int8]{
+			Input:         -128.5,
+			Options:       withRounding(safecast.RoundHalfAwayFromZero),
+			ExpectedError: safecast.ErrExceedMinimumValue,
+		},
+		"no underflow when rounded half up": MapTest[float32, int8]{
+			Input:          -128.5,
+			Options:        withRounding(safecast.RoundHalfUp),
+			ExpectedOutput: -128,
+		},
+		"unknown mode truncates": MapTest[float64, int]{
+			Input:          2.7,
+			Options:        withRounding(safecast.RoundingMode(42)),
+			ExpectedOutput: 2,
+		},
+		"rounding is ignored for float targets": MapTest[float64, float32]{
+			Input:          2.5,
+			Options:        withRounding(safecast.RoundCeil),
+			ExpectedOutput: 2.5,
+		},
+		"decimal loss is reported on rounded value": MapTest[float64, int]{
+			Input:         2.5,
+			Options:       []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfToEven), safecast.WithDecimalLossReport()},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"decimal loss is reported for negative values rounded to zero": MapTest[float64, uint]{
+			Input:         -0.4,
+			Options:       []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfUp), safecast.WithDecimalLossReport()},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"no decimal loss for integer values": MapTest[float64, int]{
+			Input:          -3,
+			Options:        []safecast.ConvertOption{safecast.WithRounding(safecast.RoundCeil), safecast.WithDecimalLossReport()},
+			ExpectedOutput: -3,
+		},
+		"Inf is still reported": MapTest[float64, int]{
+			Input:         math.Inf(1),
+			Options:       withRounding(safecast.RoundFloor),
+			ExpectedError: safecast.ErrExceedMaximumValue,
+		},
+		"NaN is still reported": MapTest[float64, int]{
+			Input:         math.NaN(),
+			Options:       withRounding(safecast.RoundFloor),
+			ExpectedError: safecast.ErrUnsupportedConversion,
+		},
+		"saturation applies on rounded value": MapTest[float64, uint8]{
+			Input:          255.6,
+			Options:        []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfUp), safecast.WithSaturation()},
+			ExpectedOutput: math.MaxUint8,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	for mode, want := range map[safecast.RoundingMode]string{
+		safecast.RoundTruncate:         "truncate",
+		safecast.RoundFloor:            "floor",
+		safecast.RoundCeil:             "ceil",
+		safecast.RoundHalfAwayFromZero: "half-away-from-zero",
+		safecast.RoundHalfToEven:       "half-to-even",
+		safecast.RoundHalfUp:           "half-up",
+		safecast.RoundingMode(42):      "unknown",
+	} {
+		assertEqual(t, want, mode.String())
+	}
+}
+
+func ExampleWithRounding() {
+	for _, mode := range []safecast.RoundingMode{
+		safecast.RoundTruncate,
+		safecast.RoundFloor,
+		safecast.RoundCeil,
+		safecast.RoundHalfAwayFromZero,
+		safecast.RoundHalfToEven,
+		safecast.RoundHalfUp,
+	} {
+		a, _ := safecast.Convert[int](2.5, safecast.WithRounding(mode))
+		b, _ := safecast.Convert[int](-2.5, safecast.WithRounding(mode))
+		fmt.Printf("%-20s 2.5 => %d, -2.5 => %d\n", mode, a, b)
+	}
+
+	// the range is checked on the rounded value
+	_, err := safecast.Convert[uint8](255.6, safecast.WithRounding(safecast.RoundHalfUp))
+	fmt.Println(err)
+
+	// Output:
+	// truncate             2.5 => 2, -2.5 => -2
+	// floor                2.5 => 2, -2.5 => -3
+	// ceil                 2.5 => 3, -2.5 => -2
+	// half-away-from-zero  2.5 => 3, -2.5 => -3
+	// half-to-even         2.5 => 2, -2.5 => -2
+	// half-up              2.5 => 3, -2.5 => -2
+	// conversion issue: 255.6 (float64) is greater than 255 (uint8): maximum value for this type exceeded
+}
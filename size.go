@@ -0,0 +1,73 @@
+package safecast
+
+import (
+	"math/big"
+)
+
+// sizeUnits are the units accepted by [ParseSize].
+var sizeUnits = newUnitTable(map[string]*big.Rat{
+	"":  big.NewRat(1, 1),
+	"B": big.NewRat(1, 1),
+
+	// SI units, powers of 1000
+	"k": powerOf(1000, 1), "K": powerOf(1000, 1), "kB": powerOf(1000, 1), "KB": powerOf(1000, 1),
+	"M": powerOf(1000, 2), "MB": powerOf(1000, 2),
+	"G": powerOf(1000, 3), "GB": powerOf(1000, 3),
+	"T": powerOf(1000, 4), "TB": powerOf(1000, 4),
+	"P": powerOf(1000, 5), "PB": powerOf(1000, 5),
+	"E": powerOf(1000, 6), "EB": powerOf(1000, 6),
+
+	// IEC units, powers of 1024
+	"Ki": powerOf(1024, 1), "KiB": powerOf(1024, 1),
+	"Mi": powerOf(1024, 2), "MiB": powerOf(1024, 2),
+	"Gi": powerOf(1024, 3), "GiB": powerOf(1024, 3),
+	"Ti": powerOf(1024, 4), "TiB": powerOf(1024, 4),
+	"Pi": powerOf(1024, 5), "PiB": powerOf(1024, 5),
+	"Ei": powerOf(1024, 6), "EiB": powerOf(1024, 6),
+})
+
+// ParseSize parses a size in bytes with an optional unit, such as "512MiB", "1.5GB", or "10k",
+// and converts it to the desired [Number] type.
+//
+// # Units
+//
+//   - SI units are powers of 1000: "kB" (or "KB"), "MB", "GB", "TB", "PB", and "EB".
+//   - IEC units are powers of 1024: "KiB", "MiB", "GiB", "TiB", "PiB", and "EiB".
+//   - The trailing "B" is optional, so "10k" is 10000 and "10Ki" is 10240.
+//   - A number without unit, or with the "B" unit, is a number of bytes.
+//   - A space is accepted between the number and the unit, such as "512 MiB".
+//
+// Units are case-sensitive, use [WithCaseInsensitiveUnits] to accept "mb" or "kib".
+//
+// # Behavior
+//
+// The number can be fractional, such as "1.5GB". The value is computed exactly once multiplied by the unit,
+// then it is converted like [ConvertFromBigRat] does: it is truncated toward zero for integer types,
+// and [ErrDecimalLoss] can be reported with [WithDecimalLossReport] using [WithConvertOptions].
+//
+// # Errors
+//
+//   - [ErrExceedMaximumValue] and [ErrExceedMinimumValue] are wrapped when the size, once multiplied by the unit,
+//     is outside the range of the desired type (example: "5GB" to uint32).
+//   - [ErrStringConversion] is wrapped when the string is not a valid size (example: "5XB").
+//   - [ErrConversionIssue] is always wrapped on failure.
+//
+// # Options
+//
+// The [ParseOption]s related to the number syntax are honored, such as [WithLocale],
+// as well as [WithParseField], [WithParseMessageFormatter], and [WithConvertOptions].
+// The base options are ignored, the number is always decimal.
+func ParseSize[NumOut Number](s string, opts ...ParseOption) (NumOut, error) {
+	return parseWith(s, opts, func(s string, options *parseConfig) (NumOut, error) {
+		return parseWithUnits[NumOut](s, sizeUnits, options)
+	})
+}
+
+// WithCaseInsensitiveUnits makes the units case-insensitive when used with [ParseSize], such as "mb" for "MB".
+//
+// It is ignored by [ParseQuantity], where the case of the suffixes matters.
+func WithCaseInsensitiveUnits() ParseOption {
+	return func(pc *parseConfig) {
+		pc.caseInsensitiveUnits = true
+	}
+}
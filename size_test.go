@@ -0,0 +1,99 @@
+package safecast_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParseSize(t *testing.T) {
+	caseInsensitive := []safecast.ParseOption{safecast.WithCaseInsensitiveUnits()}
+
+	for name, c := range map[string]TestRunner{
+		"bytes":              MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512", ExpectedOutput: 512},
+		"bytes with unit":    MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512B", ExpectedOutput: 512},
+		"kilo":               MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10k", ExpectedOutput: 10_000},
+		"kilo uppercase":     MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10K", ExpectedOutput: 10_000},
+		"kilobytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10kB", ExpectedOutput: 10_000},
+		"kilobytes KB":       MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10KB", ExpectedOutput: 10_000},
+		"megabytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "3MB", ExpectedOutput: 3_000_000},
+		"gigabytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1.5GB", ExpectedOutput: 1_500_000_000},
+		"terabytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "2T", ExpectedOutput: 2_000_000_000_000},
+		"petabytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "2PB", ExpectedOutput: 2_000_000_000_000_000},
+		"exabytes":           MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "18EB", ExpectedOutput: 18_000_000_000_000_000_000},
+		"kibibytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10KiB", ExpectedOutput: 10_240},
+		"kibi":               MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "10Ki", ExpectedOutput: 10_240},
+		"mebibytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512MiB", ExpectedOutput: 512 << 20},
+		"gibibytes":          MapTestParse[int32]{Parse: safecast.ParseSize[int32], Input: "1.5GiB", ExpectedOutput: 3 << 29},
+		"tebibytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1TiB", ExpectedOutput: 1 << 40},
+		"pebibytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1PiB", ExpectedOutput: 1 << 50},
+		"exbibytes":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "15.5EiB", ExpectedOutput: 31 << 59},
+		"space before unit":  MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512 MiB", ExpectedOutput: 512 << 20},
+		"fractional":         MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: ".5k", ExpectedOutput: 500},
+		"truncated":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1.0001k", ExpectedOutput: 1000},
+		"float":              MapTestParse[float64]{Parse: safecast.ParseSize[float64], Input: "1.5KiB", ExpectedOutput: 1536},
+		"negative":           MapTestParse[int64]{Parse: safecast.ParseSize[int64], Input: "-1.5k", ExpectedOutput: -1500},
+		"locale":             MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1.234,5 MB", ParseOptions: []safecast.ParseOption{safecast.WithLocale(safecast.LocaleGerman)}, ExpectedOutput: 1_234_500_000},
+		"case insensitive":   MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512mib", ParseOptions: caseInsensitive, ExpectedOutput: 512 << 20},
+		"case insensitive b": MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "2gb", ParseOptions: caseInsensitive, ExpectedOutput: 2_000_000_000},
+		"saturated":          MapTestParse[uint32]{Parse: safecast.ParseSize[uint32], Input: "5GB", ParseOptions: []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithSaturation())}, ExpectedOutput: math.MaxUint32},
+
+		"overflow after multiplying": MapTestParse[uint32]{
+			Parse:         safecast.ParseSize[uint32],
+			Input:         "5GB",
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: "conversion issue: 5GB (string) is greater than 4294967295 (uint32): maximum value for this type exceeded",
+		},
+		"overflow uint64":   MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "16EiB", ExpectedError: safecast.ErrExceedMaximumValue},
+		"negative unsigned": MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "-1KB", ExpectedError: safecast.ErrExceedMinimumValue},
+		"decimal loss": MapTestParse[uint64]{
+			Parse:         safecast.ParseSize[uint64],
+			Input:         "1.5B",
+			ParseOptions:  []safecast.ParseOption{safecast.WithConvertOptions(safecast.WithDecimalLossReport())},
+			ExpectedError: safecast.ErrDecimalLoss,
+		},
+		"unknown unit": MapTestParse[uint64]{
+			Parse:         safecast.ParseSize[uint64],
+			Input:         "5XB",
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "conversion issue: cannot convert from `5XB` to uint64",
+		},
+		"case sensitive": MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512mib", ExpectedError: safecast.ErrStringConversion},
+		"bits":           MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512Mb", ExpectedError: safecast.ErrStringConversion},
+		"empty":          MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "", ExpectedError: safecast.ErrStringConversion},
+		"only unit":      MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "MiB", ExpectedError: safecast.ErrStringConversion},
+		"only dot":       MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: ".MiB", ExpectedError: safecast.ErrStringConversion},
+		"exponent":       MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1e3", ExpectedError: safecast.ErrStringConversion},
+		"fraction":       MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "1/3KB", ExpectedError: safecast.ErrStringConversion},
+		"two spaces":     MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512  MiB", ExpectedError: safecast.ErrStringConversion},
+		"trailing space": MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "512 ", ExpectedError: safecast.ErrStringConversion},
+		"leading space":  MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: " 512", ExpectedError: safecast.ErrStringConversion},
+		"unit before":    MapTestParse[uint64]{Parse: safecast.ParseSize[uint64], Input: "MiB512", ExpectedError: safecast.ErrStringConversion},
+		"with field": MapTestParse[uint32]{
+			Parse:         safecast.ParseSize[uint32],
+			Input:         "8GiB",
+			ParseOptions:  []safecast.ParseOption{safecast.WithParseField("resources.memory")},
+			ExpectedError: safecast.ErrExceedMaximumValue,
+			ErrorContains: `conversion issue: field "resources.memory": 8GiB (string) is greater than 4294967295 (uint32)`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleParseSize() {
+	for _, s := range []string{"512MiB", "1.5GB", "10k", "8GiB"} {
+		size, err := safecast.ParseSize[uint32](s)
+		fmt.Println(size, err)
+	}
+
+	// Output:
+	// 536870912 <nil>
+	// 1500000000 <nil>
+	// 10000 <nil>
+	// 0 conversion issue: 8GiB (string) is greater than 4294967295 (uint32): maximum value for this type exceeded
+}
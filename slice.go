@@ -0,0 +1,133 @@
+package safecast
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// IndexError is the error reported for an element of a slice that failed to be converted.
+//
+// It wraps the error reported by [Convert] for this element, so [errors.Is] and [errors.As] work as expected.
+type IndexError struct {
+	Index int   // index of the element in the slice
+	Err   error // error reported when converting the element
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// ConvertSlice converts a slice of any [Number] to a slice of the desired [Number] type.
+//
+// Each element is converted with [Convert] and the provided [ConvertOption]s.
+//
+// # Behavior
+//
+//   - All the elements are converted, even when some of them fail, so every failure is reported at once.
+//   - The elements that failed to be converted hold the value returned by [Convert].
+//   - A nil slice gives a nil slice.
+//
+// # Errors
+//
+// The returned error joins an [*IndexError] for each element that failed, in the order of the slice,
+// as [errors.Join] does.
+//
+// [errors.Is] can be used on the returned error as it is done with [Convert] (example: [ErrExceedMaximumValue]),
+// and [errors.As] can be used to retrieve the [*IndexError] of the first failing element.
+//
+// Use [AppendConvert] to reuse an existing slice and avoid allocations.
+func ConvertSlice[NumOut Number, NumIn Number](in []NumIn, opts ...ConvertOption) ([]NumOut, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	return AppendConvert(make([]NumOut, 0, len(in)), in, opts...)
+}
+
+// AppendConvert converts the elements of src with [Convert], and appends them to dst.
+//
+// It works the same way as [ConvertSlice], but it allows to reuse a buffer to avoid allocations:
+//
+//	buf, err = AppendConvert(buf[:0], samples)
+//
+// The indexes reported in the [*IndexError] are the ones of src.
+//
+// # Performance
+//
+// The minimum and maximum values of src are computed first. When both of them can be converted,
+// all the elements are within the range of the desired type, and they are converted with a plain
+// type conversion instead of calling [Convert] for each of them.
+// Otherwise, or when an option requires to check each element (such as [WithDecimalLossReport] or [WithPrecisionLossReport]),
+// the elements are converted one by one.
+func AppendConvert[NumOut Number, NumIn Number](dst []NumOut, src []NumIn, opts ...ConvertOption) ([]NumOut, error) {
+	config := newConvertOptions(opts...)
+
+	if isSliceInRange[NumOut](src, config) {
+		dst = slices.Grow(dst, len(src))
+		for _, v := range src {
+			dst = append(dst, NumOut(v))
+		}
+		return dst, nil
+	}
+
+	var errs []error
+	for i, v := range src {
+		converted, err := convert[NumOut](v, config)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+		}
+		dst = append(dst, converted)
+	}
+
+	return dst, joinErrors(errs...)
+}
+
+// isSliceInRange reports whether all the elements of src can be converted with a plain type conversion,
+// giving the same result as [Convert] would.
+//
+// It scans src once to find its minimum and maximum values, and only checks them with [Convert],
+// as the conversion is monotonic.
+func isSliceInRange[NumOut Number, NumIn Number](src []NumIn, config *convertConfig) bool {
+	if len(src) == 0 {
+		return true
+	}
+
+	if isFloat[NumIn]() && (config.reportDecimalLoss || config.rounding != RoundTruncate) {
+		// these options depend on the decimal part of each element
+		return false
+	}
+
+	if isFloat[NumOut]() && config.reportPrecisionLoss {
+		// the precision loss depends on each element, not only on the range
+		return false
+	}
+
+	checkNaN := isFloat[NumIn]()
+	lowest, highest := src[0], src[0]
+	for _, v := range src {
+		if checkNaN && math.IsNaN(float64(v)) {
+			// NaN cannot be compared, and it cannot be converted anyway
+			return false
+		}
+
+		if v < lowest {
+			lowest = v
+		}
+		if v > highest {
+			highest = v
+		}
+	}
+
+	if _, err := convertNumber[NumOut](lowest, config); err != nil {
+		return false
+	}
+	if _, err := convertNumber[NumOut](highest, config); err != nil {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,275 @@
+package safecast_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+type MapSliceTest[TypeInput safecast.Number, TypeOutput safecast.Number] struct {
+	Input           []TypeInput
+	Options         []safecast.ConvertOption
+	ExpectedOutput  []TypeOutput
+	ExpectedIndexes []int
+	ExpectedError   error
+}
+
+func (mt MapSliceTest[I, O]) Run(t *testing.T) {
+	t.Helper()
+
+	out, err := safecast.ConvertSlice[O](mt.Input, mt.Options...)
+	assertEqual(t, len(mt.ExpectedOutput), len(out))
+	assertEqual(t, mt.ExpectedOutput == nil, out == nil)
+	for i := range mt.ExpectedOutput {
+		assertEqual(t, mt.ExpectedOutput[i], out[i])
+	}
+
+	if mt.ExpectedError == nil {
+		assertNoError(t, err)
+		return
+	}
+
+	requireErrorIs(t, err, safecast.ErrConversionIssue)
+	requireErrorIs(t, err, mt.ExpectedError)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("error is not a joined error: %v", err)
+	}
+
+	errs := joined.Unwrap()
+	assertEqual(t, len(mt.ExpectedIndexes), len(errs))
+	for i, e := range errs {
+		var indexErr *safecast.IndexError
+		if !errors.As(e, &indexErr) {
+			t.Fatalf("error is not an IndexError: %v", e)
+		}
+		assertEqual(t, mt.ExpectedIndexes[i], indexErr.Index)
+	}
+}
+
+func TestConvertSlice(t *testing.T) {
+	for name, tt := range map[string]TestRunner{
+		"nil slice":   MapSliceTest[int64, int32]{Input: nil, ExpectedOutput: nil},
+		"empty slice": MapSliceTest[int64, int32]{Input: []int64{}, ExpectedOutput: []int32{}},
+		"int64 to int32": MapSliceTest[int64, int32]{
+			Input:          []int64{0, 42, -42, math.MaxInt32, math.MinInt32},
+			ExpectedOutput: []int32{0, 42, -42, math.MaxInt32, math.MinInt32},
+		},
+		"int64 to uint16 with errors": MapSliceTest[int64, uint16]{
+			Input:           []int64{42, -1, math.MaxUint16, math.MaxUint16 + 1},
+			ExpectedOutput:  []uint16{42, math.MaxUint16, math.MaxUint16, 0},
+			ExpectedIndexes: []int{1, 3},
+			ExpectedError:   safecast.ErrRangeOverflow,
+		},
+		"float64 to int8": MapSliceTest[float64, int8]{
+			Input:          []float64{1.5, -2.5, 127.9},
+			ExpectedOutput: []int8{1, -2, 127},
+		},
+		"with options": MapSliceTest[int, uint8]{
+			Input:          []int{-1, 42, 1000},
+			Options:        []safecast.ConvertOption{safecast.WithSaturation()},
+			ExpectedOutput: []uint8{0, 42, math.MaxUint8},
+		},
+		"with decimal loss": MapSliceTest[float32, int]{
+			Input:           []float32{1, 2.5, 3},
+			Options:         []safecast.ConvertOption{safecast.WithDecimalLossReport()},
+			ExpectedOutput:  []int{1, 2, 3},
+			ExpectedIndexes: []int{1},
+			ExpectedError:   safecast.ErrDecimalLoss,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+
+	t.Run("NaN", func(t *testing.T) {
+		// the value of the failing elements is implementation-specific for floats, so only the error is checked
+		out, err := safecast.ConvertSlice[int8]([]float64{1.5, math.NaN(), 200})
+		assertEqual(t, 3, len(out))
+		assertEqual(t, int8(1), out[0])
+		requireErrorIs(t, err, safecast.ErrUnsupportedConversion)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "index 1: conversion issue: NaN (float64) is not supported")
+	})
+
+	t.Run("first error can be retrieved", func(t *testing.T) {
+		_, err := safecast.ConvertSlice[uint8]([]int{1, -2, 300})
+		requireErrorIs(t, err, safecast.ErrExceedMinimumValue)
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		requireErrorContains(t, err, "index 1: conversion issue: -2 (int) is less than 0 (uint8)")
+		requireErrorContains(t, err, "index 2: conversion issue: 300 (int) is greater than 255 (uint8)")
+
+		var indexErr *safecast.IndexError
+		if !errors.As(err, &indexErr) {
+			t.Fatal("error is not an IndexError")
+		}
+		assertEqual(t, 1, indexErr.Index)
+		requireErrorIs(t, indexErr, safecast.ErrExceedMinimumValue)
+	})
+}
+
+func TestAppendConvert(t *testing.T) {
+	t.Run("reuse buffer", func(t *testing.T) {
+		buf := make([]int16, 0, 8)
+
+		out, err := safecast.AppendConvert(buf, []int64{1, 2, 3})
+		assertNoError(t, err)
+		assertEqual(t, 3, len(out))
+		assertEqual(t, &buf[:1][0], &out[0])
+
+		out, err = safecast.AppendConvert(out[:0], []int64{4, 5})
+		assertNoError(t, err)
+		assertEqual(t, 2, len(out))
+		assertEqual(t, &buf[:1][0], &out[0])
+		assertEqual(t, int16(4), out[0])
+		assertEqual(t, int16(5), out[1])
+	})
+
+	t.Run("append to existing elements", func(t *testing.T) {
+		out, err := safecast.AppendConvert([]uint8{1, 2}, []float64{3, 400, 5})
+		requireErrorIs(t, err, safecast.ErrExceedMaximumValue)
+		assertEqual(t, 5, len(out))
+		assertEqual(t, uint8(5), out[4])
+
+		var indexErr *safecast.IndexError
+		if !errors.As(err, &indexErr) {
+			t.Fatal("error is not an IndexError")
+		}
+		// the index is the one of the source slice
+		assertEqual(t, 1, indexErr.Index)
+	})
+
+	t.Run("nil slices", func(t *testing.T) {
+		out, err := safecast.AppendConvert[int8, int64](nil, nil)
+		assertNoError(t, err)
+		assertEqual(t, 0, len(out))
+	})
+}
+
+func ExampleConvertSlice() {
+	out, err := safecast.ConvertSlice[uint16]([]int64{42, -1, 1 << 20})
+	fmt.Println(out)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, safecast.ErrRangeOverflow))
+
+	// Output:
+	// [42 65535 0]
+	// index 1: conversion issue: -1 (int64) is less than 0 (uint16): minimum value for this type exceeded
+	// index 2: conversion issue: 1048576 (int64) is greater than 65535 (uint16): maximum value for this type exceeded
+	// true
+}
+
+func TestConvertSlice_rangeScan(t *testing.T) {
+	// the elements are converted with a plain type conversion when the slice is within range,
+	// these tests make sure the result is the same as the one of Convert
+
+	for name, tt := range map[string]TestRunner{
+		"boundaries": MapSliceTest[int64, int8]{
+			Input:          []int64{math.MaxInt8, 0, math.MinInt8},
+			ExpectedOutput: []int8{math.MaxInt8, 0, math.MinInt8},
+		},
+		"minimum out of range": MapSliceTest[int64, int8]{
+			Input:           []int64{math.MaxInt8, 0, math.MinInt8 - 1},
+			ExpectedOutput:  []int8{math.MaxInt8, 0, math.MaxInt8},
+			ExpectedIndexes: []int{2},
+			ExpectedError:   safecast.ErrExceedMinimumValue,
+		},
+		"maximum out of range": MapSliceTest[uint64, int64]{
+			Input:           []uint64{math.MaxInt64 + 1, 0},
+			ExpectedOutput:  []int64{math.MinInt64, 0},
+			ExpectedIndexes: []int{0},
+			ExpectedError:   safecast.ErrExceedMaximumValue,
+		},
+		"float truncated": MapSliceTest[float64, uint8]{
+			Input:          []float64{-0.9, 255.9, 0.5},
+			ExpectedOutput: []uint8{0, 255, 0},
+		},
+		"float rounded": MapSliceTest[float64, uint8]{
+			Input:          []float64{-0.9, 254.4, 255.5},
+			Options:        []safecast.ConvertOption{safecast.WithRounding(safecast.RoundHalfAwayFromZero), safecast.WithSaturation()},
+			ExpectedOutput: []uint8{0, 254, 255},
+		},
+		"float64 to float32": MapSliceTest[float64, float32]{
+			Input:          []float64{-math.MaxFloat32, 0.1, math.MaxFloat32},
+			ExpectedOutput: []float32{-math.MaxFloat32, 0.1, math.MaxFloat32},
+		},
+		"precision loss": MapSliceTest[int64, float64]{
+			Input:           []int64{0, 1<<53 + 1, 1 << 53},
+			Options:         []safecast.ConvertOption{safecast.WithPrecisionLossReport()},
+			ExpectedOutput:  []float64{0, 1 << 53, 1 << 53},
+			ExpectedIndexes: []int{1},
+			ExpectedError:   safecast.ErrPrecisionLoss,
+		},
+		"Inf": MapSliceTest[float64, float64]{
+			Input:           []float64{0, math.Inf(1)},
+			ExpectedOutput:  []float64{0, math.Inf(1)},
+			ExpectedIndexes: []int{1},
+			ExpectedError:   safecast.ErrExceedMaximumValue,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tt.Run(t)
+		})
+	}
+}
+
+func benchmarkSamples(n int) []int64 {
+	samples := make([]int64, n)
+	for i := range samples {
+		samples[i] = int64(i%math.MaxUint16) - math.MaxInt16
+	}
+	return samples
+}
+
+func BenchmarkConvertSlice(b *testing.B) {
+	samples := benchmarkSamples(1 << 20)
+	dst := make([]int16, 0, len(samples))
+
+	b.Run("AppendConvert", func(b *testing.B) {
+		b.SetBytes(int64(len(samples)) * 8)
+		for i := 0; i < b.N; i++ {
+			var err error
+			dst, err = safecast.AppendConvert(dst[:0], samples)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("AppendConvert out of range", func(b *testing.B) {
+		outOfRange := append(benchmarkSamples(len(samples)-1), math.MaxInt64)
+		b.SetBytes(int64(len(samples)) * 8)
+		for i := 0; i < b.N; i++ {
+			dst, _ = safecast.AppendConvert(dst[:0], outOfRange)
+		}
+	})
+
+	b.Run("Convert loop", func(b *testing.B) {
+		b.SetBytes(int64(len(samples)) * 8)
+		for i := 0; i < b.N; i++ {
+			dst = dst[:0]
+			for _, v := range samples {
+				converted, err := safecast.Convert[int16](v)
+				if err != nil {
+					b.Fatal(err)
+				}
+				dst = append(dst, converted)
+			}
+		}
+	})
+
+	b.Run("cast loop", func(b *testing.B) {
+		b.SetBytes(int64(len(samples)) * 8)
+		for i := 0; i < b.N; i++ {
+			dst = dst[:0]
+			for _, v := range samples {
+				dst = append(dst, int16(v))
+			}
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package safecast
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WithStrict only accepts the canonical form of the numbers when used with [Parse],
+// so a value has exactly one accepted textual representation.
+//
+// It is meant for security-sensitive inputs, such as identifiers found in URLs or in signed tokens.
+//
+// The following strings are rejected, [ErrStringConversion] is returned with the reason in [ConversionError.Reason]:
+//
+//   - leading or trailing whitespace, such as " 42".
+//   - superfluous leading zeros, such as "042" or "00.5". This includes the legacy octal form with [WithBaseAutoDetection].
+//   - superfluous trailing zeros in the fractional part, such as "1.50" or "1.0".
+//   - missing digits around the decimal separator, such as ".5" or "5.".
+//   - exponents, such as "1e3" or "0e5".
+//   - plus signs, such as "+42".
+//   - underscores, such as "1_000" with [WithBaseAutoDetection].
+//   - negative zero, such as "-0".
+//   - uppercase letters, such as "FF" with [WithBaseHexadecimal], "0X1F" with [WithBaseAutoDetection], or "1E3".
+//
+// The separators set with [WithLocale], [WithThousandsSeparator], and [WithDecimalSeparator] are accepted,
+// but the thousands separator is then required, so "1,234" is accepted and "1234" is rejected
+// with [WithThousandsSeparator] set to ','. When the separator is a space, the non-breaking spaces are rejected.
+//
+// With [WithOptionalBasePrefix], the prefix is required, so "0xff" is accepted and "ff" is rejected.
+// With [WithAlphabet], the letters must have the case of the alphabet, so "ZZ" is accepted and "zz" is rejected
+// with [AlphabetCrockford].
+//
+// Example:
+//
+//	value, err := Parse[uint64]("042", WithStrict())
+//	// conversion issue: cannot convert from `042` to uint64: leading zero
+func WithStrict() ParseOption {
+	return func(pc *parseConfig) {
+		pc.strict = true
+	}
+}
+
+// strictViolation returns the reason why the number is not in its canonical form, or "" when it is.
+//
+// orig is the string to parse, and s the same string once the separators are normalized.
+func (pc *parseConfig) strictViolation(orig, s string) string {
+	switch {
+	case strings.TrimSpace(orig) != orig:
+		return "leading or trailing whitespace"
+	case strings.HasPrefix(s, "+"):
+		return "plus sign"
+	case strings.Contains(s, "_"):
+		return "underscore"
+	case strings.IndexFunc(s, unicode.IsUpper) >= 0:
+		return "uppercase letter"
+	}
+
+	if pc.thousandsSeparator != 0 {
+		if reason := pc.strictGroupingViolation(orig); reason != "" {
+			return reason
+		}
+	}
+
+	digits, isNegative := strings.CutPrefix(s, "-")
+
+	// the exponent is only found in decimal numbers, "e" being a digit in hexadecimal
+	hasExponent := pc.numberBase == baseDecimal
+	switch {
+	case pc.numberBase == baseAuto:
+		hasExponent = true
+		for _, base := range []numberBase{baseHexadecimal, baseBinary, baseOctal} {
+			if rest, ok := cutBasePrefix(digits, base); ok {
+				digits = rest
+				hasExponent = false
+				break
+			}
+		}
+	case pc.optionalBasePrefix && len(basePrefixes[pc.numberBase]) > 0:
+		var hasPrefix bool
+		digits, hasPrefix = cutBasePrefix(digits, pc.numberBase)
+		if !hasPrefix {
+			return "missing base prefix"
+		}
+	}
+
+	if hasExponent && strings.Contains(digits, "e") {
+		return "exponent"
+	}
+
+	integer, fraction, hasFraction := strings.Cut(digits, ".")
+	switch {
+	case len(integer) > 1 && integer[0] == '0':
+		return "leading zero"
+	case hasFraction && integer == "":
+		return "missing integer digit"
+	case hasFraction && fraction == "":
+		return "missing fractional digit"
+	case isNegative && strings.Contains(digits, "0") && strings.Trim(digits, "0.") == "":
+		return "negative zero"
+	case hasFraction && strings.Trim(fraction, "0") == "":
+		return "zero fractional part"
+	case strings.HasSuffix(fraction, "0"):
+		return "trailing zero"
+	}
+	return ""
+}
+
+// strictGroupingViolation returns the reason why the digits of orig are not grouped with the thousands separator,
+// or "" when they are.
+func (pc *parseConfig) strictGroupingViolation(orig string) string {
+	integer := strings.TrimPrefix(orig, "-")
+	if decimalSeparator := pc.decimalSeparator; decimalSeparator != pc.thousandsSeparator {
+		if decimalSeparator == 0 {
+			decimalSeparator = '.'
+		}
+		integer, _, _ = strings.Cut(integer, string(decimalSeparator))
+	}
+
+	switch {
+	case pc.thousandsSeparator == ' ' && strings.ContainsAny(integer, "\u00a0\u202f"):
+		return "non-breaking space"
+	case strings.ContainsRune(integer, pc.thousandsSeparator):
+		// the groups were already checked when normalizing the separators
+		return ""
+	case len(integer) > 3 && isDigits(integer):
+		return "missing thousands separator"
+	}
+	return ""
+}
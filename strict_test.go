@@ -0,0 +1,135 @@
+package safecast_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ccoveille/go-safecast/v2"
+)
+
+func TestParse_withStrict(t *testing.T) {
+	strict := []safecast.ParseOption{safecast.WithStrict()}
+	strictAuto := []safecast.ParseOption{safecast.WithStrict(), safecast.WithBaseAutoDetection()}
+	strictHex := []safecast.ParseOption{safecast.WithStrict(), safecast.WithBaseHexadecimal()}
+	strictThousands := []safecast.ParseOption{safecast.WithStrict(), safecast.WithThousandsSeparator(',')}
+
+	for name, c := range map[string]TestRunner{
+		"integer":             MapTestParse[uint64]{Input: "42", ParseOptions: strict, ExpectedOutput: 42},
+		"zero":                MapTestParse[int]{Input: "0", ParseOptions: strict, ExpectedOutput: 0},
+		"negative":            MapTestParse[int]{Input: "-42", ParseOptions: strict, ExpectedOutput: -42},
+		"float":               MapTestParse[float64]{Input: "0.5", ParseOptions: strict, ExpectedOutput: 0.5},
+		"negative float":      MapTestParse[float64]{Input: "-0.5", ParseOptions: strict, ExpectedOutput: -0.5},
+		"float with integer":  MapTestParse[float64]{Input: "10.25", ParseOptions: strict, ExpectedOutput: 10.25},
+		"zero in fraction":    MapTestParse[float64]{Input: "1.05", ParseOptions: strict, ExpectedOutput: 1.05},
+		"zero hex digit":      MapTestParse[uint8]{Input: "e0", ParseOptions: strictHex, ExpectedOutput: 0xe0},
+		"exponent digit hex":  MapTestParse[uint16]{Input: "0x1e3", ParseOptions: strictAuto, ExpectedOutput: 0x1e3},
+		"lowercase hex":       MapTestParse[uint8]{Input: "ff", ParseOptions: strictHex, ExpectedOutput: 255},
+		"hex prefix":          MapTestParse[uint8]{Input: "0xff", ParseOptions: strictAuto, ExpectedOutput: 255},
+		"hex prefix and zero": MapTestParse[uint8]{Input: "0x0", ParseOptions: strictAuto, ExpectedOutput: 0},
+		"zero with base auto": MapTestParse[uint8]{Input: "0", ParseOptions: strictAuto, ExpectedOutput: 0},
+		"locale": MapTestParse[int]{
+			Input:          "1 234",
+			ParseOptions:   []safecast.ParseOption{safecast.WithStrict(), safecast.WithLocale(safecast.LocaleFrench)},
+			ExpectedOutput: 1234,
+		},
+		"grouped":               MapTestParse[int]{Input: "-1,234,567", ParseOptions: strictThousands, ExpectedOutput: -1234567},
+		"grouped with fraction": MapTestParse[float64]{Input: "1,234.5", ParseOptions: strictThousands, ExpectedOutput: 1234.5},
+		"short group":           MapTestParse[int]{Input: "123", ParseOptions: strictThousands, ExpectedOutput: 123},
+		"grouped same separator": MapTestParse[int]{
+			Input:          "1.234",
+			ParseOptions:   []safecast.ParseOption{safecast.WithStrict(), safecast.WithThousandsSeparator('.')},
+			ExpectedOutput: 1234,
+		},
+
+		"leading whitespace":        MapTestParse[int]{Input: " 42", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading or trailing whitespace"},
+		"trailing whitespace":       MapTestParse[int]{Input: "42\n", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading or trailing whitespace"},
+		"plus sign":                 MapTestParse[int]{Input: "+42", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "plus sign"},
+		"plus sign float":           MapTestParse[float64]{Input: "+4.2", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "plus sign"},
+		"underscore":                MapTestParse[int]{Input: "1_000", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "underscore"},
+		"leading zero":              MapTestParse[int]{Input: "042", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"leading zeros":             MapTestParse[int]{Input: "-0042", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"leading zero float":        MapTestParse[float64]{Input: "00.5", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"legacy octal":              MapTestParse[int]{Input: "042", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"leading zero in hex":       MapTestParse[int]{Input: "0x0f", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "leading zero"},
+		"negative zero":             MapTestParse[int]{Input: "-0", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "negative zero"},
+		"negative zero float":       MapTestParse[float64]{Input: "-0.0", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "negative zero"},
+		"negative zero hex":         MapTestParse[int]{Input: "-0x0", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "negative zero"},
+		"uppercase hex":             MapTestParse[uint8]{Input: "FF", ParseOptions: strictHex, ExpectedError: safecast.ErrStringConversion, ErrorContains: "uppercase letter"},
+		"mixed case hex":            MapTestParse[uint8]{Input: "fF", ParseOptions: strictHex, ExpectedError: safecast.ErrStringConversion, ErrorContains: "uppercase letter"},
+		"uppercase prefix":          MapTestParse[uint8]{Input: "0Xff", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "uppercase letter"},
+		"uppercase exponent":        MapTestParse[int]{Input: "1E3", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "uppercase letter"},
+		"exponent":                  MapTestParse[int]{Input: "1e3", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "exponent"},
+		"exponent with zero":        MapTestParse[int]{Input: "10e0", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "exponent"},
+		"exponent of zero":          MapTestParse[int]{Input: "0e5", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "exponent"},
+		"exponent with base auto":   MapTestParse[int]{Input: "1e3", ParseOptions: strictAuto, ExpectedError: safecast.ErrStringConversion, ErrorContains: "exponent"},
+		"exponent to float":         MapTestParse[float64]{Input: "2.5e-1", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "exponent"},
+		"trailing zero":             MapTestParse[float64]{Input: "1.50", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "trailing zero"},
+		"zero fractional part":      MapTestParse[int]{Input: "1.0", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "zero fractional part"},
+		"zero with fractional part": MapTestParse[float64]{Input: "0.00", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "zero fractional part"},
+		"missing integer digit":     MapTestParse[float64]{Input: ".5", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing integer digit"},
+		"missing negative integer":  MapTestParse[float64]{Input: "-.5", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing integer digit"},
+		"missing fractional digit":  MapTestParse[float64]{Input: "5.", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing fractional digit"},
+		"trailing zero with locale": MapTestParse[float64]{
+			Input:         "1,50",
+			ParseOptions:  []safecast.ParseOption{safecast.WithStrict(), safecast.WithLocale(safecast.LocaleFrench)},
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "trailing zero",
+		},
+		"missing thousands separator":          MapTestParse[int]{Input: "1234", ParseOptions: strictThousands, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing thousands separator"},
+		"missing negative thousands separator": MapTestParse[int]{Input: "-1234", ParseOptions: strictThousands, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing thousands separator"},
+		"missing thousands separator in float": MapTestParse[float64]{Input: "1234.5", ParseOptions: strictThousands, ExpectedError: safecast.ErrStringConversion, ErrorContains: "missing thousands separator"},
+		"misplaced thousands separator":        MapTestParse[int]{Input: "12,34", ParseOptions: strictThousands, ExpectedError: safecast.ErrStringConversion},
+		"non-breaking space": MapTestParse[int]{
+			Input:         "1\u00a0234",
+			ParseOptions:  []safecast.ParseOption{safecast.WithStrict(), safecast.WithLocale(safecast.LocaleFrench)},
+			ExpectedError: safecast.ErrStringConversion,
+			ErrorContains: "non-breaking space",
+		},
+		"invalid": MapTestParse[int]{Input: "-.", ParseOptions: strict, ExpectedError: safecast.ErrStringConversion},
+
+		"out of range": MapTestParse[uint8]{Input: "256", ParseOptions: strict, ExpectedError: safecast.ErrExceedMaximumValue},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func TestParse_withStrict_reason(t *testing.T) {
+	_, err := safecast.Parse[int]("+42", safecast.WithStrict())
+
+	var convErr *safecast.ConversionError
+	if !errors.As(err, &convErr) {
+		t.Fatalf("expected a ConversionError, got %v", err)
+	}
+	assertEqual(t, "plus sign", convErr.Reason)
+}
+
+func TestParse_withoutStrict(t *testing.T) {
+	for name, c := range map[string]TestRunner{
+		"leading zero":  MapTestParse[int]{Input: "042", ExpectedOutput: 42},
+		"negative zero": MapTestParse[int]{Input: "-0", ExpectedOutput: 0},
+		"uppercase hex": MapTestParse[uint8]{Input: "FF", ParseOptions: []safecast.ParseOption{safecast.WithBaseHexadecimal()}, ExpectedOutput: 255},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Run(t)
+		})
+	}
+}
+
+func ExampleWithStrict() {
+	i, err := safecast.Parse[uint64]("42", safecast.WithStrict())
+	fmt.Println(i, err)
+
+	_, err = safecast.Parse[uint64]("042", safecast.WithStrict())
+	fmt.Println(err)
+
+	_, err = safecast.Parse[uint8]("FF", safecast.WithStrict(), safecast.WithBaseHexadecimal())
+	fmt.Println(err)
+
+	// Output:
+	// 42 <nil>
+	// conversion issue: cannot convert from `042` to uint64: leading zero
+	// conversion issue: cannot convert from `FF` to uint8 (base hexadecimal): uppercase letter
+}
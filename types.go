@@ -58,21 +58,46 @@ func minOf[T Number]() any {
 		return float64(-math.MaxFloat64)
 	case isFloat32[T]():
 		return float32(-math.MaxFloat32)
-	case isUnsigned[T]():
-		return T(0)
 	}
-	v := int64(1) << (8*sizeOf[T]() - 1)
-	return T(v)
+	return minValue[T]()
 }
 
 func maxOf[T Number]() any {
 	switch {
 	case isFloat64[T]():
 		return float64(math.MaxFloat64)
-	// there is no case for float64, as nothing can overflow it
 	case isFloat32[T]():
 		return float32(math.MaxFloat32)
 	}
+	return maxValue[T]()
+}
+
+// minValue is the typed counterpart of [minOf]
+func minValue[T Number]() T {
+	switch {
+	case isFloat64[T]():
+		v := -math.MaxFloat64
+		return T(v)
+	case isFloat32[T]():
+		v := -math.MaxFloat32
+		return T(v)
+	case isUnsigned[T]():
+		return 0
+	}
+	v := int64(1) << (8*sizeOf[T]() - 1)
+	return T(v)
+}
+
+// maxValue is the typed counterpart of [maxOf]
+func maxValue[T Number]() T {
+	switch {
+	case isFloat64[T]():
+		v := math.MaxFloat64
+		return T(v)
+	case isFloat32[T]():
+		v := math.MaxFloat32
+		return T(v)
+	}
 	v := uint64(1)<<(8*sizeOf[T]()-1) - 1
 	if isUnsigned[T]() {
 		v = v*2 + 1
@@ -0,0 +1,119 @@
+package safecast
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// unit is a suffix of a number, such as "KiB" in "512KiB", and the value it multiplies the number by.
+type unit struct {
+	suffix     string
+	multiplier *big.Rat
+}
+
+// unitTable is a list of units sorted by decreasing length of their suffix,
+// so the longest suffix is matched first, such as "KiB" before "B".
+type unitTable []unit
+
+// newUnitTable returns a [unitTable] from the multipliers of the suffixes.
+func newUnitTable(multipliers map[string]*big.Rat) unitTable {
+	table := make(unitTable, 0, len(multipliers))
+	for suffix, multiplier := range multipliers {
+		table = append(table, unit{suffix: suffix, multiplier: multiplier})
+	}
+	sort.Slice(table, func(i, j int) bool {
+		if len(table[i].suffix) != len(table[j].suffix) {
+			return len(table[i].suffix) > len(table[j].suffix)
+		}
+		return table[i].suffix < table[j].suffix
+	})
+	return table
+}
+
+// cut returns the number before the suffix of s, and the multiplier of the suffix.
+//
+// It reports false if no suffix of the table matches.
+func (t unitTable) cut(s string, caseInsensitive bool) (number string, multiplier *big.Rat, ok bool) {
+	for _, u := range t {
+		if len(s) < len(u.suffix) {
+			continue
+		}
+
+		suffix := s[len(s)-len(u.suffix):]
+		if suffix == u.suffix || caseInsensitive && strings.EqualFold(suffix, u.suffix) {
+			number := s[:len(s)-len(u.suffix)]
+			if u.suffix != "" {
+				// a space is allowed between the number and the unit, such as "512 MiB"
+				number = strings.TrimSuffix(number, " ")
+			}
+			return number, u.multiplier, true
+		}
+	}
+	return "", nil, false
+}
+
+// powerOf returns base^exponent as a [*big.Rat].
+func powerOf(base int64, exponent int64) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(exponent), nil))
+}
+
+// parseWithUnits parses a decimal number followed by one of the units of the table,
+// and converts the number multiplied by the unit to the desired type.
+func parseWithUnits[NumOut Number](orig string, table unitTable, options *parseConfig) (NumOut, error) {
+	number, multiplier, ok := table.cut(orig, options.caseInsensitiveUnits)
+	if !ok {
+		return 0, newConversionError[NumOut](orig, ErrStringConversion)
+	}
+	return parseMultiplied[NumOut](orig, number, multiplier, options)
+}
+
+// parseMultiplied parses the decimal number, and converts it multiplied by multiplier to the desired type.
+//
+// orig is the string reported in the errors.
+func parseMultiplied[NumOut Number](orig, number string, multiplier *big.Rat, options *parseConfig) (NumOut, error) {
+	value, ok := parseDecimalRat(number, options)
+	if !ok {
+		return 0, newConversionError[NumOut](orig, ErrStringConversion)
+	}
+	value.Mul(value, multiplier)
+
+	converted, err := ConvertFromBigRat[NumOut](value, options.convertOptions...)
+	if err != nil {
+		// the original string is reported, as the multiplied value doesn't appear in it
+		return converted, withValue(orig, err)
+	}
+	return converted, nil
+}
+
+// parseDecimalRat parses a decimal number, such as "-1.5", according to the separators of the options.
+func parseDecimalRat(s string, options *parseConfig) (*big.Rat, bool) {
+	s, ok := options.normalizeSeparators(s)
+	if !ok || !isDecimalNumber(s) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// isDecimalNumber reports whether s is a decimal number with an optional sign and fractional part,
+// such as "-1.5", without the other syntaxes accepted by [big.Rat], such as "1e3" or "1/3".
+func isDecimalNumber(s string) bool {
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" {
+		return false
+	}
+	return isDigits(integer) && isDigits(fraction)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}